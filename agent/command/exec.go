@@ -249,6 +249,10 @@ func addTempDirs(env map[string]string, dir string) {
 }
 
 func (c *subprocessExec) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := requireJasperManager(c); err != nil {
+		return errors.WithStack(err)
+	}
+
 	var err error
 
 	if err = c.doExpansions(conf.Expansions); err != nil {
@@ -313,6 +313,10 @@ func (c *gitFetchProject) buildModuleCloneCommand(conf *internal.TaskConfig, opt
 // Execute gets the source code required by the project
 // Retries some number of times before failing
 func (c *gitFetchProject) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := requireJasperManager(c); err != nil {
+		return errors.WithStack(err)
+	}
+
 	err := util.Retry(
 		ctx,
 		func() (bool, error) {
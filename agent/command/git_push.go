@@ -47,6 +47,10 @@ func (c *gitPush) ParseParams(params map[string]interface{}) error {
 
 // Execute gets the source code required by the project
 func (c *gitPush) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := requireJasperManager(c); err != nil {
+		return errors.WithStack(err)
+	}
+
 	if err := util.ExpandValues(c, conf.Expansions); err != nil {
 		return errors.Wrap(err, "can't apply expansions")
 	}
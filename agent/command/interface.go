@@ -8,6 +8,7 @@ import (
 	"github.com/evergreen-ci/evergreen/agent/internal"
 	"github.com/evergreen-ci/evergreen/agent/internal/client"
 	"github.com/mongodb/jasper"
+	"github.com/pkg/errors"
 )
 
 // Command is an interface that defines a command
@@ -110,3 +111,14 @@ func (b *base) JasperManager() jasper.Manager {
 
 	return b.jasper
 }
+
+// requireJasperManager returns an error if the given command wasn't
+// injected with a Jasper manager. Commands that spawn processes should
+// call this at the top of Execute to fail with a clear error instead of
+// panicking on a nil manager.
+func requireJasperManager(c Command) error {
+	if c.JasperManager() == nil {
+		return errors.Errorf("programmer error: command '%s' was not given a Jasper manager", c.Name())
+	}
+	return nil
+}
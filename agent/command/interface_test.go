@@ -0,0 +1,19 @@
+package command
+
+import (
+	"testing"
+
+	"github.com/mongodb/jasper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRequireJasperManager(t *testing.T) {
+	cmd := shellExecFactory().(*shellExec)
+	assert.Error(t, requireJasperManager(cmd))
+
+	jpm, err := jasper.NewSynchronizedManager(false)
+	require.NoError(t, err)
+	cmd.SetJasperManager(jpm)
+	assert.NoError(t, requireJasperManager(cmd))
+}
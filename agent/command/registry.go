@@ -54,7 +54,9 @@ func init() {
 		"subprocess.exec":               subprocessExecFactory,
 		"subprocess.scripting":          subprocessScriptingFactory,
 		"setup.initial":                 initialSetupFactory,
+		"test.log.upload":               testLogUploadFactory,
 		"timeout.update":                timeoutUpdateFactory,
+		"timeout.wait":                  timeoutWaitFactory,
 	}
 
 	for name, factory := range cmds {
@@ -430,6 +430,10 @@ func (c *scriptingExec) getHarnessConfig(output options.Output) (options.Scripti
 }
 
 func (c *scriptingExec) Execute(ctx context.Context, comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := requireJasperManager(c); err != nil {
+		return errors.WithStack(err)
+	}
+
 	var err error
 
 	if err = c.doExpansions(conf.Expansions); err != nil {
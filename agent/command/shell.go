@@ -94,6 +94,10 @@ func (c *shellExec) ParseParams(params map[string]interface{}) error {
 
 // Execute starts the shell with its given parameters.
 func (c *shellExec) Execute(ctx context.Context, _ client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+	if err := requireJasperManager(c); err != nil {
+		return errors.WithStack(err)
+	}
+
 	logger.Execution().Debug("Preparing script...")
 
 	var err error
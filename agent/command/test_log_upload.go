@@ -0,0 +1,113 @@
+package command
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/evergreen-ci/utility"
+	"github.com/mitchellh/mapstructure"
+	"github.com/mongodb/grip"
+	"github.com/pkg/errors"
+)
+
+// testLogUpload uploads a directory of log files, one per matched test,
+// as individual test logs. It's intended for custom test harnesses that
+// write their output to a directory of flat files rather than producing
+// results in a format evergreen already understands.
+type testLogUpload struct {
+	// Files is a list of log files, using gitignore syntax.
+	Files []string `mapstructure:"files" plugin:"expand"`
+
+	// Prefix is an optional directory prefix to start file globbing in, relative to Evergreen's working directory.
+	Prefix string `mapstructure:"prefix" plugin:"expand"`
+
+	// Optional, when set to true, causes this command to be skipped over without an error when
+	// the path specified in files does not exist. Defaults to false, which triggers errors
+	// for missing files.
+	Optional bool `mapstructure:"optional"`
+
+	base
+}
+
+func testLogUploadFactory() Command   { return &testLogUpload{} }
+func (c *testLogUpload) Name() string { return "test.log.upload" }
+
+func (c *testLogUpload) ParseParams(params map[string]interface{}) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrapf(err, "error decoding '%s' params", c.Name())
+	}
+
+	if len(c.Files) == 0 {
+		return errors.Errorf("error validating params: must specify at least one "+
+			"file pattern to parse: '%+v'", params)
+	}
+	return nil
+}
+
+func (c *testLogUpload) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	if err := util.ExpandValues(c, conf.Expansions); err != nil {
+		return errors.Wrap(err, "error expanding params")
+	}
+
+	workDir := filepath.Join(conf.WorkDir, c.Prefix)
+	include, err := utility.NewGitignoreFileMatcher(workDir, c.Files...)
+	if err != nil {
+		return errors.Wrap(err, "building gitignore file matcher")
+	}
+	b := utility.FileListBuilder{
+		WorkingDir: workDir,
+		Include:    include,
+	}
+	files, err := b.Build()
+	if err != nil {
+		return errors.Wrap(err, "problem building wildcard paths")
+	}
+
+	if len(files) == 0 {
+		err = errors.New("expanded file specification had no items")
+		if c.Optional {
+			logger.Task().Warning(err)
+			return nil
+		}
+		return err
+	}
+
+	td := client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}
+	catcher := grip.NewBasicCatcher()
+	for _, f := range files {
+		contents, err := ioutil.ReadFile(filepath.Join(workDir, f))
+		if err != nil {
+			catcher.Wrapf(err, "problem reading test log file '%s'", f)
+			continue
+		}
+
+		testLog := &model.TestLog{
+			Name:          testNameFromLogFile(f),
+			Task:          conf.Task.Id,
+			TaskExecution: conf.Task.Execution,
+			Lines:         strings.Split(string(contents), "\n"),
+		}
+		if _, err = comm.SendTestLog(ctx, td, testLog); err != nil {
+			catcher.Wrapf(err, "problem uploading test log for '%s'", f)
+			continue
+		}
+		logger.Task().Infof("uploaded test log for '%s'", f)
+	}
+
+	return catcher.Resolve()
+}
+
+// testNameFromLogFile derives a test name from a log file's base name,
+// stripping its extension.
+func testNameFromLogFile(fn string) string {
+	base := filepath.Base(fn)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
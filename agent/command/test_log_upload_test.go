@@ -0,0 +1,95 @@
+package command
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/suite"
+)
+
+type TestLogUploadSuite struct {
+	suite.Suite
+	cmd    *testLogUpload
+	conf   *internal.TaskConfig
+	comm   client.Communicator
+	logger client.LoggerProducer
+	mock   *client.Mock
+	ctx    context.Context
+	cancel context.CancelFunc
+	tmpdir string
+}
+
+func TestTestLogUploadSuite(t *testing.T) {
+	suite.Run(t, new(TestLogUploadSuite))
+}
+
+func (s *TestLogUploadSuite) SetupSuite() {
+	var err error
+	s.tmpdir, err = ioutil.TempDir("", "evergreen.command.test_log_upload.test")
+	s.Require().NoError(err)
+
+	s.Require().NoError(ioutil.WriteFile(filepath.Join(s.tmpdir, "suite_case.log"), []byte("line1\nline2"), 0644))
+}
+
+func (s *TestLogUploadSuite) TearDownSuite() {
+	s.Require().NoError(os.RemoveAll(s.tmpdir))
+}
+
+func (s *TestLogUploadSuite) SetupTest() {
+	var err error
+	s.ctx, s.cancel = context.WithCancel(context.Background())
+	s.comm = client.NewMock("http://localhost.com")
+	s.conf = &internal.TaskConfig{Expansions: &util.Expansions{}, Task: &task.Task{}, Project: &model.Project{}}
+	s.logger, err = s.comm.GetLoggerProducer(s.ctx, client.TaskData{ID: s.conf.Task.Id, Secret: s.conf.Task.Secret}, nil)
+	s.NoError(err)
+	s.cmd = testLogUploadFactory().(*testLogUpload)
+	s.conf.WorkDir = s.tmpdir
+	s.mock = s.comm.(*client.Mock)
+}
+
+func (s *TestLogUploadSuite) TearDownTest() {
+	s.cancel()
+}
+
+func (s *TestLogUploadSuite) TestParseErrorIfNothingIsSet() {
+	s.Error(s.cmd.ParseParams(map[string]interface{}{}))
+}
+
+func (s *TestLogUploadSuite) TestParseErrorsIfTypesDoNotMatch() {
+	s.Error(s.cmd.ParseParams(map[string]interface{}{
+		"files": 1,
+	}))
+}
+
+func (s *TestLogUploadSuite) TestUploadsMatchingFiles() {
+	s.NoError(s.cmd.ParseParams(map[string]interface{}{
+		"files": []string{"*.log"},
+	}))
+	s.NoError(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+	s.Require().Len(s.mock.TestLogs, 1)
+	s.Equal("suite_case", s.mock.TestLogs[0].Name)
+	s.Equal([]string{"line1", "line2"}, s.mock.TestLogs[0].Lines)
+}
+
+func (s *TestLogUploadSuite) TestErrorsIfNoFilesMatch() {
+	s.NoError(s.cmd.ParseParams(map[string]interface{}{
+		"files": []string{"nonexistent-*.log"},
+	}))
+	s.Error(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+}
+
+func (s *TestLogUploadSuite) TestOptionalSkipsErrorIfNoFilesMatch() {
+	s.NoError(s.cmd.ParseParams(map[string]interface{}{
+		"files":    []string{"nonexistent-*.log"},
+		"optional": true,
+	}))
+	s.NoError(s.cmd.Execute(s.ctx, s.comm, s.logger, s.conf))
+}
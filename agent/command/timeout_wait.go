@@ -0,0 +1,65 @@
+package command
+
+import (
+	"context"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/mitchellh/mapstructure"
+	"github.com/pkg/errors"
+)
+
+// timeoutWait pauses the task for a configured duration, or until it's
+// interrupted by the agent's idle timeout or context cancellation. It's
+// useful as an explicit pause step in project configs.
+type timeoutWait struct {
+	// DurationSecs is the number of seconds to sleep for.
+	DurationSecs int `mapstructure:"duration" plugin:"expand"`
+
+	base
+}
+
+func timeoutWaitFactory() Command   { return &timeoutWait{} }
+func (c *timeoutWait) Name() string { return "timeout.wait" }
+
+func (c *timeoutWait) ParseParams(params map[string]interface{}) error {
+	if err := mapstructure.Decode(params, c); err != nil {
+		return errors.Wrapf(err, "error decoding '%s' params", c.Name())
+	}
+
+	if c.DurationSecs <= 0 {
+		return errors.Errorf("error validating params: 'duration' must be a positive number of seconds: '%+v'", params)
+	}
+	return nil
+}
+
+func (c *timeoutWait) Execute(ctx context.Context,
+	comm client.Communicator, logger client.LoggerProducer, conf *internal.TaskConfig) error {
+
+	if err := util.ExpandValues(c, conf.Expansions); err != nil {
+		return errors.Wrap(err, "error expanding params")
+	}
+
+	duration := time.Duration(c.DurationSecs) * time.Second
+	logger.Task().Infof("waiting for %s", duration)
+
+	// Make sure the agent's idle timeout watchdog doesn't kill the task
+	// out from under an intentionally long wait.
+	if c.IdleTimeout() < duration {
+		c.SetIdleTimeout(duration)
+	}
+
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		logger.Task().Info("finished waiting")
+		return nil
+	case <-ctx.Done():
+		logger.Task().Info("wait interrupted by context cancellation")
+		return errors.Wrap(ctx.Err(), "wait command canceled")
+	}
+}
@@ -0,0 +1,70 @@
+package command
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/evergreen-ci/evergreen/agent/internal"
+	"github.com/evergreen-ci/evergreen/agent/internal/client"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
+	"github.com/evergreen-ci/evergreen/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeoutWaitParseParams(t *testing.T) {
+	cmd := timeoutWaitFactory().(*timeoutWait)
+	assert.Error(t, cmd.ParseParams(map[string]interface{}{}))
+	assert.Error(t, cmd.ParseParams(map[string]interface{}{"duration": 0}))
+	assert.Error(t, cmd.ParseParams(map[string]interface{}{"duration": -1}))
+
+	cmd = timeoutWaitFactory().(*timeoutWait)
+	assert.NoError(t, cmd.ParseParams(map[string]interface{}{"duration": 1}))
+	assert.Equal(t, 1, cmd.DurationSecs)
+}
+
+func TestTimeoutWaitExecute(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	comm := client.NewMock("http://localhost.com")
+	conf := &internal.TaskConfig{Expansions: &util.Expansions{}, Task: &task.Task{}, Project: &model.Project{}}
+	logger, err := comm.GetLoggerProducer(ctx, client.TaskData{ID: conf.Task.Id, Secret: conf.Task.Secret}, nil)
+	require.NoError(t, err)
+
+	t.Run("WaitsForDuration", func(t *testing.T) {
+		cmd := timeoutWaitFactory().(*timeoutWait)
+		require.NoError(t, cmd.ParseParams(map[string]interface{}{"duration": 1}))
+		start := time.Now()
+		assert.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("RespectsContextCancellation", func(t *testing.T) {
+		cmd := timeoutWaitFactory().(*timeoutWait)
+		require.NoError(t, cmd.ParseParams(map[string]interface{}{"duration": 60}))
+		cancelCtx, cancelFn := context.WithCancel(ctx)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			cancelFn()
+		}()
+		assert.Error(t, cmd.Execute(cancelCtx, comm, logger, conf))
+	})
+
+	t.Run("ExtendsIdleTimeoutToCoverDuration", func(t *testing.T) {
+		cmd := timeoutWaitFactory().(*timeoutWait)
+		require.NoError(t, cmd.ParseParams(map[string]interface{}{"duration": 1}))
+		assert.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+		assert.Equal(t, time.Second, cmd.IdleTimeout())
+	})
+
+	t.Run("DoesNotShrinkALargerExplicitIdleTimeout", func(t *testing.T) {
+		cmd := timeoutWaitFactory().(*timeoutWait)
+		require.NoError(t, cmd.ParseParams(map[string]interface{}{"duration": 1}))
+		cmd.SetIdleTimeout(time.Hour)
+		assert.NoError(t, cmd.Execute(ctx, comm, logger, conf))
+		assert.Equal(t, time.Hour, cmd.IdleTimeout())
+	})
+}
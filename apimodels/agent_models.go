@@ -20,6 +20,7 @@ const (
 	DefaultContainerWaitTimeoutSecs = 600
 	DefaultPollFrequency            = 30
 	DefaultRetries                  = 2
+	MaxNumHosts                     = 10
 )
 
 // TaskStartRequest holds information sent by the agent to the
@@ -268,8 +269,8 @@ func (ch *CreateHost) setNumHosts() error {
 		if err != nil {
 			return errors.Errorf("problem parsing '%s' as an int", ch.NumHosts)
 		}
-		if numHosts > 10 || numHosts < 0 {
-			return errors.New("num_hosts must be between 1 and 10")
+		if numHosts > MaxNumHosts || numHosts < 0 {
+			return errors.Errorf("num_hosts must be between 1 and %d", MaxNumHosts)
 		} else if numHosts == 0 {
 			ch.NumHosts = "1"
 		}
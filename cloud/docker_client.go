@@ -3,7 +3,6 @@
 package cloud
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
@@ -407,8 +406,12 @@ func (c *dockerClientImpl) CreateContainer(ctx context.Context, parentHost, cont
 	return nil
 }
 
-// GetDockerLogs returns output logs or error logs, based on the given options.
-// This assumes the container is not using TTY.
+// GetDockerLogs returns a reader over the container's output logs or error
+// logs, based on the given options. This assumes the container is not using
+// TTY. The returned reader is live: it's backed by a pipe that's fed from
+// the Docker log stream as data arrives, rather than buffering the whole
+// stream first, so callers can stream the output (e.g. with Follow set)
+// without waiting for the container to finish.
 func (c *dockerClientImpl) GetDockerLogs(ctx context.Context, containerID string, parent *host.Host, options types.ContainerLogsOptions) (io.Reader, error) {
 	dockerClient, err := c.generateClient(parent)
 	if err != nil {
@@ -418,18 +421,30 @@ func (c *dockerClientImpl) GetDockerLogs(ctx context.Context, containerID string
 	if err != nil {
 		return nil, errors.Wrapf(err, "Docker logs API call failed for container %s", containerID)
 	}
-	tempout := &bytes.Buffer{}
-	temperr := &bytes.Buffer{}
 
-	_, err = stdcopy.StdCopy(tempout, temperr, stream)
-	if err != nil {
-		return nil, errors.Wrapf(err, "Error copying stream for container %s", containerID)
-	}
+	pr, pw := io.Pipe()
+	go func() {
+		defer stream.Close()
+
+		stdout := io.Writer(ioutil.Discard)
+		stderr := io.Writer(ioutil.Discard)
+		// When both stdout and stderr are requested, demux both streams into
+		// the same pipe so the output is interleaved in the order Docker
+		// produced it, rather than separated into two streams.
+		switch {
+		case options.ShowStdout && options.ShowStderr:
+			stdout, stderr = pw, pw
+		case options.ShowStdout:
+			stdout = pw
+		case options.ShowStderr:
+			stderr = pw
+		}
 
-	if options.ShowStdout {
-		return tempout, nil
-	}
-	return temperr, nil
+		_, copyErr := stdcopy.StdCopy(stdout, stderr, stream)
+		pw.CloseWithError(errors.Wrapf(copyErr, "error copying stream for container %s", containerID))
+	}()
+
+	return pr, nil
 }
 
 func (c *dockerClientImpl) GetDockerStatus(ctx context.Context, containerID string, parent *host.Host) (*ContainerStatus, error) {
@@ -460,10 +460,15 @@ func (mockMgr *mockManager) GetVolumeAttachment(ctx context.Context, volumeID st
 }
 
 func (mockMgr *mockManager) GetInstanceStatuses(ctx context.Context, hosts []host.Host) ([]CloudStatus, error) {
-	if len(hosts) != 1 {
-		return nil, errors.New("expecting 1 hosts")
+	statuses := make([]CloudStatus, 0, len(hosts))
+	for _, h := range hosts {
+		status, err := mockMgr.GetInstanceStatus(ctx, &h)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting status of host %s", h.Id)
+		}
+		statuses = append(statuses, status)
 	}
-	return []CloudStatus{StatusRunning}, nil
+	return statuses, nil
 }
 
 func (m *mockManager) CheckInstanceType(ctx context.Context, instanceType string) error {
@@ -72,7 +72,10 @@ func buildApp() *cli.App {
 		operations.PatchRemoveModule(),
 		operations.PatchFinalize(),
 		operations.PatchCancel(),
+		operations.PatchDiffConfig(),
 		operations.CreateVersion(),
+		operations.VersionAbort(),
+		operations.VersionRestart(),
 	}
 
 	userHome, err := homedir.Dir()
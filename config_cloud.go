@@ -84,6 +84,10 @@ type AWSConfig struct {
 	TaskSync S3Credentials `bson:"task_sync" json:"task_sync" yaml:"task_sync"`
 	// TaskSyncRead stores credentials for reading task data in S3.
 	TaskSyncRead S3Credentials `bson:"task_sync_read" json:"task_sync_read" yaml:"task_sync_read"`
+	// TaskSyncReadRoleARN, if set, is the IAM role assumed to mint
+	// temporary credentials scoped to a single task's sync prefix,
+	// rather than returning the unscoped TaskSyncRead credentials.
+	TaskSyncReadRoleARN string `bson:"task_sync_read_role_arn" json:"task_sync_read_role_arn" yaml:"task_sync_read_role_arn"`
 
 	DefaultSecurityGroup string `bson:"default_security_group" json:"default_security_group" yaml:"default_security_group"`
 
@@ -97,6 +101,10 @@ type S3Credentials struct {
 	Key    string `bson:"key" json:"key" yaml:"key"`
 	Secret string `bson:"secret" json:"secret" yaml:"secret"`
 	Bucket string `bson:"bucket" json:"bucket" yaml:"bucket"`
+	// Token is an optional session token, set when these are temporary
+	// credentials (e.g. minted via STS AssumeRole) rather than a long-lived
+	// access key.
+	Token string `bson:"token,omitempty" json:"token,omitempty" yaml:"token,omitempty"`
 }
 
 func (c *S3Credentials) Validate() error {
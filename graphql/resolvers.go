@@ -1878,7 +1878,7 @@ func (r *mutationResolver) AbortTask(ctx context.Context, taskID string) (*restM
 		return nil, InternalServerError.Send(ctx, fmt.Sprintf("error finding project by id: %s: %s", t.Project, err.Error()))
 	}
 	user := gimlet.GetUser(ctx).DisplayName()
-	err = model.AbortTask(taskID, user)
+	err = model.AbortTask(taskID, user, "")
 	if err != nil {
 		return nil, InternalServerError.Send(ctx, fmt.Sprintf("Error aborting task %s: %s", taskID, err.Error()))
 	}
@@ -42,6 +42,7 @@ type CommitQueue struct {
 	ProjectID             string            `bson:"_id"`
 	Processing            bool              `bson:"processing"`
 	ProcessingUpdatedTime time.Time         `bson:"processing_updated_time"`
+	ProcessingOwner       string            `bson:"processing_owner"`
 	Queue                 []CommitQueueItem `bson:"queue,omitempty"`
 }
 
@@ -130,6 +131,29 @@ func (q *CommitQueue) Remove(issue string) (bool, error) {
 	return true, nil
 }
 
+// RemoveAndCleanup behaves like Remove, but additionally clears any
+// commit-queue-dequeue subscriptions tied to the removed item, so a direct removal
+// (i.e. one that doesn't go through RemoveItemAndPreventMerge) doesn't leave them
+// orphaned.
+func (q *CommitQueue) RemoveAndCleanup(issue string) (bool, error) {
+	itemIndex := q.FindItem(issue)
+	if itemIndex < 0 {
+		return false, nil
+	}
+	item := q.Queue[itemIndex]
+
+	removed, err := q.Remove(issue)
+	if err != nil || !removed {
+		return removed, err
+	}
+
+	if err := clearVersionPatchSubscriber(item.Issue, event.CommitQueueDequeueSubscriberType); err != nil {
+		return removed, errors.Wrapf(err, "can't clear subscriptions for item '%s'", issue)
+	}
+
+	return removed, nil
+}
+
 func (q *CommitQueue) UpdateVersion(item CommitQueueItem) error {
 	return errors.Wrapf(addVersionID(q.ProjectID, item), "error updating version")
 }
@@ -143,6 +167,21 @@ func (q *CommitQueue) FindItem(issue string) int {
 	return -1
 }
 
+// Len returns the number of items in the queue.
+func (q *CommitQueue) Len() int {
+	return len(q.Queue)
+}
+
+// IsEmpty returns whether the queue has no items.
+func (q *CommitQueue) IsEmpty() bool {
+	return q.Len() == 0
+}
+
+// ContainsIssue returns whether issue is currently in the queue.
+func (q *CommitQueue) ContainsIssue(issue string) bool {
+	return q.FindItem(issue) >= 0
+}
+
 func (q *CommitQueue) SetProcessing(status bool) error {
 	q.Processing = status
 	if err := setProcessing(q.ProjectID, status); err != nil {
@@ -152,6 +191,34 @@ func (q *CommitQueue) SetProcessing(status bool) error {
 	return nil
 }
 
+// SetProcessingWithOwner behaves like SetProcessing, but additionally records which
+// worker holds the processing lock and for how long that claim is valid. If processing
+// is true, the lock is only acquired if the queue is not already locked by another,
+// unexpired owner, so a crashed worker's claim can be taken over automatically once ttl
+// has elapsed.
+func (q *CommitQueue) SetProcessingWithOwner(processing bool, owner string, ttl time.Duration) error {
+	if err := setProcessingWithOwner(q.ProjectID, processing, owner, ttl); err != nil {
+		return errors.Wrapf(err, "can't set processing with owner '%s' on queue id '%s'", owner, q.ProjectID)
+	}
+	q.Processing = processing
+	q.ProcessingOwner = owner
+
+	return nil
+}
+
+// ClearProcessing forcibly releases the processing lock on the queue, regardless of
+// which owner holds it or whether its ttl has expired. This lets an operator recover a
+// queue that's stuck processing without waiting for the lock to expire on its own.
+func (q *CommitQueue) ClearProcessing() error {
+	if err := setProcessingWithOwner(q.ProjectID, false, "", 0); err != nil {
+		return errors.Wrapf(err, "can't clear processing on queue id '%s'", q.ProjectID)
+	}
+	q.Processing = false
+	q.ProcessingOwner = ""
+
+	return nil
+}
+
 func TriggersCommitQueue(commentAction string, comment string) bool {
 	if commentAction == "deleted" {
 		return false
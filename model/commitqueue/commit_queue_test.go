@@ -2,6 +2,7 @@ package commitqueue
 
 import (
 	"testing"
+	"time"
 
 	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/event"
@@ -67,6 +68,20 @@ func (s *CommitQueueSuite) TestEnqueue() {
 	s.NotEqual(-1, dbq.FindItem("c123"))
 }
 
+func (s *CommitQueueSuite) TestLenIsEmptyContainsIssue() {
+	s.Equal(0, s.q.Len())
+	s.True(s.q.IsEmpty())
+	s.False(s.q.ContainsIssue("c123"))
+
+	_, err := s.q.Enqueue(sampleCommitQueueItem)
+	s.Require().NoError(err)
+
+	s.Equal(1, s.q.Len())
+	s.False(s.q.IsEmpty())
+	s.True(s.q.ContainsIssue("c123"))
+	s.False(s.q.ContainsIssue("notqueued"))
+}
+
 func (s *CommitQueueSuite) TestEnqueueAtFront() {
 	// if queue is empty, puts as the first item
 	pos, err := s.q.EnqueueAtFront(sampleCommitQueueItem)
@@ -184,6 +199,49 @@ func (s *CommitQueueSuite) TestProcessing() {
 	s.NoError(s.q.SetProcessing(false))
 }
 
+func (s *CommitQueueSuite) TestProcessingWithOwner() {
+	s.NoError(s.q.SetProcessingWithOwner(true, "worker1", time.Minute))
+	s.True(s.q.Processing)
+	s.Equal("worker1", s.q.ProcessingOwner)
+
+	// A second worker can't acquire the lock while it's held and unexpired.
+	s.Error(s.q.SetProcessingWithOwner(true, "worker2", time.Minute))
+
+	s.NoError(s.q.SetProcessingWithOwner(false, "", time.Minute))
+	s.False(s.q.Processing)
+}
+
+func (s *CommitQueueSuite) TestProcessingWithOwnerExpiredTakeover() {
+	s.NoError(s.q.SetProcessingWithOwner(true, "worker1", time.Millisecond))
+	time.Sleep(10 * time.Millisecond)
+
+	// worker1's claim has expired, so worker2 can take over the lock.
+	s.NoError(s.q.SetProcessingWithOwner(true, "worker2", time.Minute))
+	s.True(s.q.Processing)
+	s.Equal("worker2", s.q.ProcessingOwner)
+
+	dbq, err := FindOneId(s.q.ProjectID)
+	s.NoError(err)
+	s.True(dbq.Processing)
+	s.Equal("worker2", dbq.ProcessingOwner)
+}
+
+func (s *CommitQueueSuite) TestClearProcessing() {
+	s.NoError(s.q.SetProcessingWithOwner(true, "worker1", time.Minute))
+
+	s.NoError(s.q.ClearProcessing())
+	s.False(s.q.Processing)
+	s.Empty(s.q.ProcessingOwner)
+
+	dbq, err := FindOneId(s.q.ProjectID)
+	s.NoError(err)
+	s.False(dbq.Processing)
+	s.Empty(dbq.ProcessingOwner)
+
+	// ClearProcessing works even when nothing is currently processing.
+	s.NoError(s.q.ClearProcessing())
+}
+
 func (s *CommitQueueSuite) TestClearAll() {
 	item := sampleCommitQueueItem
 	pos, err := s.q.Enqueue(item)
@@ -316,6 +374,26 @@ func TestPreventMergeForItemCLI(t *testing.T) {
 	assert.False(t, mergeBuild.Tasks[0].Activated)
 }
 
+func (s *CommitQueueSuite) TestRemoveAndCleanup() {
+	s.Require().NoError(db.Clear(event.SubscriptionsCollection))
+
+	item := sampleCommitQueueItem
+	_, err := s.q.Enqueue(item)
+	s.Require().NoError(err)
+
+	patchSub := event.NewExpiringPatchOutcomeSubscription(item.Issue, event.NewCommitQueueDequeueSubscriber())
+	s.Require().NoError(patchSub.Upsert())
+
+	removed, err := s.q.RemoveAndCleanup(item.Issue)
+	s.NoError(err)
+	s.True(removed)
+	s.True(s.q.IsEmpty())
+
+	subs, err := event.FindSubscriptions(event.ResourceTypePatch, []event.Selector{{Type: event.SelectorID, Data: item.Issue}})
+	s.NoError(err)
+	s.Empty(subs)
+}
+
 func TestClearVersionPatchSubscriber(t *testing.T) {
 	require.NoError(t, db.Clear(event.SubscriptionsCollection))
 
@@ -19,6 +19,7 @@ var (
 	QueueKey                 = bsonutil.MustHaveTag(CommitQueue{}, "Queue")
 	ProcessingKey            = bsonutil.MustHaveTag(CommitQueue{}, "Processing")
 	ProcessingUpdatedTimeKey = bsonutil.MustHaveTag(CommitQueue{}, "ProcessingUpdatedTime")
+	ProcessingOwnerKey       = bsonutil.MustHaveTag(CommitQueue{}, "ProcessingOwner")
 	IssueKey                 = bsonutil.MustHaveTag(CommitQueueItem{}, "Issue")
 	VersionKey               = bsonutil.MustHaveTag(CommitQueueItem{}, "Version")
 	EnqueueTimeKey           = bsonutil.MustHaveTag(CommitQueueItem{}, "EnqueueTime")
@@ -131,6 +132,30 @@ func setProcessing(id string, processing bool) error {
 	)
 }
 
+// setProcessingWithOwner behaves like setProcessing, but also stamps the owner of the
+// processing lock. If processing is true, the update only applies if the queue isn't
+// currently processing, or if it is, but the owner's claim has exceeded ttl -- letting a
+// new owner take over a lock abandoned by a crashed worker.
+func setProcessingWithOwner(id string, processing bool, owner string, ttl time.Duration) error {
+	query := bson.M{IdKey: id}
+	if processing {
+		query["$or"] = []bson.M{
+			{ProcessingKey: false},
+			{ProcessingUpdatedTimeKey: bson.M{"$lte": time.Now().Add(-ttl)}},
+		}
+	}
+	return updateOne(
+		query,
+		bson.M{
+			"$set": bson.M{
+				ProcessingKey:            processing,
+				ProcessingUpdatedTimeKey: time.Now(),
+				ProcessingOwnerKey:       owner,
+			},
+		},
+	)
+}
+
 func clearAll() (int, error) {
 	return updateAll(
 		struct{}{},
@@ -85,6 +85,11 @@ type BootstrapSettings struct {
 	JasperBinaryDir       string `bson:"jasper_binary_dir,omitempty" json:"jasper_binary_dir,omitempty" mapstructure:"jasper_binary_dir,omitempty"`
 	JasperCredentialsPath string `json:"jasper_credentials_path,omitempty" bson:"jasper_credentials_path,omitempty" mapstructure:"jasper_credentials_path,omitempty"`
 
+	// AgentMonitorPutRetries overrides the default number of attempts to
+	// deploy the agent monitor before the host is disabled. If unset, the
+	// default is used.
+	AgentMonitorPutRetries int `bson:"agent_monitor_put_retries,omitempty" json:"agent_monitor_put_retries,omitempty" mapstructure:"agent_monitor_put_retries,omitempty"`
+
 	// Windows-specific
 	ServiceUser string `bson:"service_user,omitempty" json:"service_user,omitempty" mapstructure:"service_user,omitempty"`
 	ShellPath   string `bson:"shell_path,omitempty" json:"shell_path,omitempty" mapstructure:"shell_path,omitempty"`
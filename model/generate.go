@@ -253,7 +253,7 @@ func (g *GeneratedProject) saveNewBuildsAndTasks(ctx context.Context, v *Version
 	// This will only be populated for patches, not mainline commits.
 	var syncAtEndOpts patch.SyncAtEndOptions
 	if patchDoc, _ := patch.FindOne(patch.ByVersion(v.Id)); patchDoc != nil {
-		if err = patchDoc.AddSyncVariantsTasks(newTVPairs.TVPairsToVariantTasks()); err != nil {
+		if err = patchDoc.AddSyncVariantsTasks(newTVPairs.TVPairsToVariantTasks(), p.TaskTagsByName()); err != nil {
 			return errors.Wrap(err, "could not update sync variants and tasks")
 		}
 		syncAtEndOpts = patchDoc.SyncAtEndOpts
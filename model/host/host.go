@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/docker/go-connections/nat"
@@ -699,17 +700,23 @@ func (h *Host) UpdateJasperCredentialsID(id string) error {
 
 // UpdateLastCommunicated sets the host's last communication time to the current time.
 func (h *Host) UpdateLastCommunicated() error {
-	now := time.Now()
+	return h.SetLastCommunicatedAt(time.Now())
+}
+
+// SetLastCommunicatedAt sets the host's last communication time to the given
+// time. Callers can set a time in the future to push back the point at which
+// the host is next considered uncommunicative (e.g. to back off retries).
+func (h *Host) SetLastCommunicatedAt(t time.Time) error {
 	err := UpdateOne(
 		bson.M{IdKey: h.Id},
 		bson.M{"$set": bson.M{
-			LastCommunicationTimeKey: now,
+			LastCommunicationTimeKey: t,
 		}})
 
 	if err != nil {
 		return err
 	}
-	h.LastCommunicationTime = now
+	h.LastCommunicationTime = t
 	return nil
 }
 
@@ -1819,6 +1826,49 @@ func (h *Host) GetParent() (*Host, error) {
 	return host, nil
 }
 
+// parentCacheTTL is how long GetParentCached may return a cached parent host
+// before re-querying the database.
+const parentCacheTTL = 30 * time.Second
+
+type parentCacheEntry struct {
+	parent    *Host
+	expiresAt time.Time
+}
+
+var (
+	parentCacheMu sync.Mutex
+	parentCache   = map[string]parentCacheEntry{}
+)
+
+// GetParentCached behaves like GetParent but caches the result in memory for
+// up to parentCacheTTL. This trades up to parentCacheTTL of staleness in the
+// returned parent (e.g. its container pool settings) for avoiding a database
+// round trip on every call, which matters for callers that poll container
+// status/logs frequently.
+func (h *Host) GetParentCached() (*Host, error) {
+	if h.ParentID == "" {
+		return nil, errors.New("Host does not have a parent")
+	}
+
+	parentCacheMu.Lock()
+	entry, ok := parentCache[h.ParentID]
+	parentCacheMu.Unlock()
+	if ok && time.Now().Before(entry.expiresAt) {
+		return entry.parent, nil
+	}
+
+	parent, err := h.GetParent()
+	if err != nil {
+		return nil, err
+	}
+
+	parentCacheMu.Lock()
+	parentCache[h.ParentID] = parentCacheEntry{parent: parent, expiresAt: time.Now().Add(parentCacheTTL)}
+	parentCacheMu.Unlock()
+
+	return parent, nil
+}
+
 // IsIdleParent determines whether a host has only inactive containers
 func (h *Host) IsIdleParent() (bool, error) {
 	const idleTimeCutoff = 20 * time.Minute
@@ -1940,11 +1990,16 @@ func FindAllHostsSpawnedByTasks() ([]Host, error) {
 }
 
 // FindHostsSpawnedByTask finds hosts spawned by the `createhost` command scoped to a given task.
-func FindHostsSpawnedByTask(taskID string, execution int) ([]Host, error) {
+// If statusFilter is empty, it defaults to only running hosts; otherwise only
+// hosts with that status are returned.
+func FindHostsSpawnedByTask(taskID string, execution int, statusFilter string) ([]Host, error) {
+	if statusFilter == "" {
+		statusFilter = evergreen.HostRunning
+	}
 	taskIDKey := bsonutil.GetDottedKeyName(SpawnOptionsKey, SpawnOptionsTaskIDKey)
 	taskExecutionNumberKey := bsonutil.GetDottedKeyName(SpawnOptionsKey, SpawnOptionsTaskExecutionNumberKey)
 	query := db.Query(bson.M{
-		StatusKey:              evergreen.HostRunning,
+		StatusKey:              statusFilter,
 		taskIDKey:              taskID,
 		taskExecutionNumberKey: execution,
 	})
@@ -1956,10 +2011,15 @@ func FindHostsSpawnedByTask(taskID string, execution int) ([]Host, error) {
 }
 
 // FindHostsSpawnedByBuild finds hosts spawned by the `createhost` command scoped to a given build.
-func FindHostsSpawnedByBuild(buildID string) ([]Host, error) {
+// If statusFilter is empty, it defaults to only running hosts; otherwise only
+// hosts with that status are returned.
+func FindHostsSpawnedByBuild(buildID, statusFilter string) ([]Host, error) {
+	if statusFilter == "" {
+		statusFilter = evergreen.HostRunning
+	}
 	buildIDKey := bsonutil.GetDottedKeyName(SpawnOptionsKey, SpawnOptionsBuildIDKey)
 	query := db.Query(bson.M{
-		StatusKey:  evergreen.HostRunning,
+		StatusKey:  statusFilter,
 		buildIDKey: buildID,
 	})
 	hosts, err := Find(query)
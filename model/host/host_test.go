@@ -2791,6 +2791,54 @@ func TestFindParentOfContainerNotParent(t *testing.T) {
 	assert.Nil(parent)
 }
 
+func TestGetParentCached(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	require.NoError(db.ClearCollections(Collection))
+	parentCache = map[string]parentCacheEntry{}
+
+	host1 := &Host{
+		Id:       "host1",
+		Host:     "host",
+		User:     "user",
+		Distro:   distro.Distro{Id: "distro"},
+		Status:   evergreen.HostRunning,
+		ParentID: "parentId",
+	}
+	host2 := &Host{
+		Id:            "parentId",
+		Distro:        distro.Distro{Id: "distro"},
+		Status:        evergreen.HostRunning,
+		HasContainers: true,
+	}
+	require.NoError(host1.Insert())
+	require.NoError(host2.Insert())
+
+	parent, err := host1.GetParentCached()
+	assert.NoError(err)
+	require.NotNil(parent)
+	assert.Equal("parentId", parent.Id)
+
+	// Removing the parent from the database should not affect a cached
+	// result returned within the TTL.
+	require.NoError(db.ClearCollections(Collection))
+	require.NoError(host1.Insert())
+
+	cachedParent, err := host1.GetParentCached()
+	assert.NoError(err)
+	require.NotNil(cachedParent)
+	assert.Equal("parentId", cachedParent.Id)
+
+	// Once the cache entry is expired, the parent is looked up again and
+	// the removal is observed.
+	parentCacheMu.Lock()
+	parentCache["parentId"] = parentCacheEntry{parent: parentCache["parentId"].parent, expiresAt: time.Now().Add(-time.Second)}
+	parentCacheMu.Unlock()
+
+	_, err = host1.GetParentCached()
+	assert.Error(err)
+}
+
 func TestLastContainerFinishTimePipeline(t *testing.T) {
 
 	require.NoError(t, db.Clear(Collection), "error clearing %v collections", Collection)
@@ -2982,22 +3030,27 @@ func TestFindHostsSpawnedByTasks(t *testing.T) {
 	assert.Equal(found[1].Id, "4")
 	assert.Equal(found[2].Id, "7")
 
-	found, err = FindHostsSpawnedByTask("task_1", 0)
+	found, err = FindHostsSpawnedByTask("task_1", 0, "")
 	assert.NoError(err)
 	assert.Len(found, 1)
 	assert.Equal(found[0].Id, "1")
 
-	found, err = FindHostsSpawnedByTask("task_1", 1)
+	found, err = FindHostsSpawnedByTask("task_1", 1, "")
 	assert.NoError(err)
 	assert.Len(found, 1)
 	assert.Equal(found[0].Id, "7")
 
-	found, err = FindHostsSpawnedByBuild("build_1")
+	found, err = FindHostsSpawnedByBuild("build_1", "")
 	assert.NoError(err)
 	assert.Len(found, 3)
 	assert.Equal(found[0].Id, "1")
 	assert.Equal(found[1].Id, "4")
 	assert.Equal(found[2].Id, "7")
+
+	found, err = FindHostsSpawnedByTask("task_1", 0, evergreen.HostDecommissioned)
+	assert.NoError(err)
+	assert.Len(found, 1)
+	assert.Equal(found[0].Id, "5")
 }
 
 func TestCountContainersOnParents(t *testing.T) {
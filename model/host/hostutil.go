@@ -683,6 +683,23 @@ func (h *Host) splunkTokenFilePath() string {
 // process with the given options, wait for its completion, and returns the
 // output from it.
 func (h *Host) RunJasperProcess(ctx context.Context, env evergreen.Environment, opts *options.Create) ([]string, error) {
+	return h.RunJasperProcessWithTimeout(ctx, env, opts, time.Duration(0))
+}
+
+// RunJasperProcessWithTimeout behaves like RunJasperProcess, but additionally
+// enforces the given timeout on the process itself (rather than just the
+// surrounding ctx), so callers like host-executed script jobs can impose a
+// hard per-process limit without having to manage their own cancellation. A
+// timeout of 0 leaves opts unmodified and relies on ctx alone, matching
+// RunJasperProcess.
+func (h *Host) RunJasperProcessWithTimeout(ctx context.Context, env evergreen.Environment, opts *options.Create, timeout time.Duration) ([]string, error) {
+	if timeout > 0 {
+		opts.Timeout = timeout
+	}
+	return h.runJasperProcess(ctx, env, opts)
+}
+
+func (h *Host) runJasperProcess(ctx context.Context, env evergreen.Environment, opts *options.Create) ([]string, error) {
 	client, err := h.JasperClient(ctx, env)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not get a Jasper client")
@@ -813,6 +813,20 @@ func TestJasperProcess(t *testing.T) {
 				assert.NoError(t, err)
 			}))
 		},
+		"RunJasperProcessWithTimeoutSetsOptsTimeout": func(ctx context.Context, t *testing.T, env *mock.Environment, manager *jmock.Manager, h *Host, opts *options.Create) {
+			assert.NoError(t, withJasperServiceSetupAndTeardown(ctx, env, manager, h, func() {
+				_, err := h.RunJasperProcessWithTimeout(ctx, env, opts, time.Minute)
+				assert.NoError(t, err)
+				assert.Equal(t, time.Minute, opts.Timeout)
+			}))
+		},
+		"RunJasperProcessWithTimeoutIgnoresZeroTimeout": func(ctx context.Context, t *testing.T, env *mock.Environment, manager *jmock.Manager, h *Host, opts *options.Create) {
+			assert.NoError(t, withJasperServiceSetupAndTeardown(ctx, env, manager, h, func() {
+				_, err := h.RunJasperProcessWithTimeout(ctx, env, opts, time.Duration(0))
+				assert.NoError(t, err)
+				assert.Zero(t, opts.Timeout)
+			}))
+		},
 		"RunJasperProcessFailsIfProcessCreationFails": func(ctx context.Context, t *testing.T, env *mock.Environment, manager *jmock.Manager, h *Host, opts *options.Create) {
 			manager.FailCreate = true
 			assert.NoError(t, withJasperServiceSetupAndTeardown(ctx, env, manager, h, func() {
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"strings"
 	"time"
 
@@ -123,7 +124,11 @@ type SyncAtEndOptions struct {
 
 type BackportInfo struct {
 	PatchID string `bson:"patch_id,omitempty" json:"patch_id,omitempty"`
-	SHA     string `bson:"sha,omitempty" json:"sha,omitempty"`
+	// PatchIDs optionally specifies multiple source patches to backport, in
+	// order, for backporting a series of commits. If set, it takes
+	// precedence over PatchID.
+	PatchIDs []string `bson:"patch_ids,omitempty" json:"patch_ids,omitempty"`
+	SHA      string   `bson:"sha,omitempty" json:"sha,omitempty"`
 }
 
 // Patch stores all details related to a patch request
@@ -188,6 +193,32 @@ func (p *Patch) SetDescription(desc string) error {
 	)
 }
 
+// SetStartTime sets the patch's start time, keyed by patch id.
+func (p *Patch) SetStartTime(t time.Time) error {
+	p.StartTime = t
+	return UpdateOne(
+		bson.M{IdKey: p.Id},
+		bson.M{
+			"$set": bson.M{
+				StartTimeKey: t,
+			},
+		},
+	)
+}
+
+// SetFinishTime sets the patch's finish time, keyed by patch id.
+func (p *Patch) SetFinishTime(t time.Time) error {
+	p.FinishTime = t
+	return UpdateOne(
+		bson.M{IdKey: p.Id},
+		bson.M{
+			"$set": bson.M{
+				FinishTimeKey: t,
+			},
+		},
+	)
+}
+
 func (p *Patch) SetMergePatch(newPatchID string) error {
 	p.MergePatch = newPatchID
 	return UpdateOne(
@@ -200,6 +231,21 @@ func (p *Patch) SetMergePatch(newPatchID string) error {
 	)
 }
 
+// SetAlias persists a new alias for the patch and updates the in-memory
+// struct. Since the alias determines the patch's requester, callers should
+// treat any previously computed requester as stale after calling this.
+func (p *Patch) SetAlias(alias string) error {
+	p.Alias = alias
+	return UpdateOne(
+		bson.M{IdKey: p.Id},
+		bson.M{
+			"$set": bson.M{
+				AliasKey: alias,
+			},
+		},
+	)
+}
+
 func (p *Patch) GetURL(uiHost string) string {
 	var url string
 	if p.Activated {
@@ -281,6 +327,37 @@ func (p *Patch) SetParameters(parameters []Parameter) error {
 	)
 }
 
+// AddParameters upserts the given parameters into the patch's existing
+// parameters by key, replacing the value of any parameter that already
+// exists and appending any that don't. If params contains duplicate keys,
+// the last value for that key wins.
+func (p *Patch) AddParameters(params []Parameter) error {
+	existing := map[string]int{}
+	for i, param := range p.Parameters {
+		existing[param.Key] = i
+	}
+
+	merged := p.Parameters
+	for _, param := range params {
+		if i, ok := existing[param.Key]; ok {
+			merged[i] = param
+		} else {
+			existing[param.Key] = len(merged)
+			merged = append(merged, param)
+		}
+	}
+
+	p.Parameters = merged
+	return UpdateOne(
+		bson.M{IdKey: p.Id},
+		bson.M{
+			"$set": bson.M{
+				ParametersKey: merged,
+			},
+		},
+	)
+}
+
 // ResolveVariantTasks returns a set of all build variants and a set of all
 // tasks that will run based on the given VariantTasks.
 func ResolveVariantTasks(vts []VariantTasks) (bvs []string, tasks []string) {
@@ -307,6 +384,41 @@ func ResolveVariantTasks(vts []VariantTasks) (bvs []string, tasks []string) {
 	return bvs, tasks
 }
 
+// AllVariantNames returns the deduped set of build variant names scheduled
+// in the patch.
+func (p *Patch) AllVariantNames() []string {
+	bvSet := map[string]bool{}
+	for _, vt := range p.VariantsTasks {
+		bvSet[vt.Variant] = true
+	}
+
+	bvs := make([]string, 0, len(bvSet))
+	for bv := range bvSet {
+		bvs = append(bvs, bv)
+	}
+	return bvs
+}
+
+// AllTaskNames returns the deduped set of task names scheduled in the patch,
+// including display task names, since those are also schedulable.
+func (p *Patch) AllTaskNames() []string {
+	taskSet := map[string]bool{}
+	for _, vt := range p.VariantsTasks {
+		for _, t := range vt.Tasks {
+			taskSet[t] = true
+		}
+		for _, dt := range vt.DisplayTasks {
+			taskSet[dt.Name] = true
+		}
+	}
+
+	tasks := make([]string, 0, len(taskSet))
+	for t := range taskSet {
+		tasks = append(tasks, t)
+	}
+	return tasks
+}
+
 // SetVariantsTasks updates the variant/tasks pairs in the database.
 // Also updates the Tasks and Variants fields to maintain backwards compatibility between
 // the old and new fields.
@@ -351,10 +463,14 @@ func (p *Patch) AddTasks(tasks []string) error {
 }
 
 // ResolveSyncVariantTasks filters the given tasks by variant to find only those
-// that match the build variant and task filters.
-func (p *Patch) ResolveSyncVariantTasks(vts []VariantTasks) []VariantTasks {
+// that match the build variant and task filters. In addition to literal task
+// names and the "all" sentinel, Tasks may contain tag selectors of the form
+// ".tagname", which are resolved against taskTags (a mapping of task name to
+// the tags defined on that task in the project), mirroring how the project
+// alias system resolves tags.
+func (p *Patch) ResolveSyncVariantTasks(vts []VariantTasks, taskTags map[string][]string) []VariantTasks {
 	bvs := p.SyncAtEndOpts.BuildVariants
-	tasks := p.SyncAtEndOpts.Tasks
+	tasks := expandTaskTagSelectors(p.SyncAtEndOpts.Tasks, taskTags)
 
 	if len(bvs) == 1 && bvs[0] == "all" {
 		bvs = []string{}
@@ -411,10 +527,39 @@ func (p *Patch) ResolveSyncVariantTasks(vts []VariantTasks) []VariantTasks {
 	return resolvedVTs
 }
 
+// expandTaskTagSelectors resolves any tag selectors (entries beginning with
+// ".") in tasks into the literal task names tagged with that tag in
+// taskTags, deduplicating the result. Entries that are not tag selectors are
+// passed through unchanged.
+func expandTaskTagSelectors(tasks []string, taskTags map[string][]string) []string {
+	if len(tasks) == 0 {
+		return tasks
+	}
+
+	expanded := []string{}
+	for _, t := range tasks {
+		if !strings.HasPrefix(t, ".") {
+			if !utility.StringSliceContains(expanded, t) {
+				expanded = append(expanded, t)
+			}
+			continue
+		}
+
+		tag := strings.TrimPrefix(t, ".")
+		for taskName, tags := range taskTags {
+			if utility.StringSliceContains(tags, tag) && !utility.StringSliceContains(expanded, taskName) {
+				expanded = append(expanded, taskName)
+			}
+		}
+	}
+
+	return expanded
+}
+
 // AddSyncVariantsTasks adds new tasks for variants filtered from the given
 // sequence of VariantsTasks to the existing synced VariantTasks.
-func (p *Patch) AddSyncVariantsTasks(vts []VariantTasks) error {
-	resolved := MergeVariantsTasks(p.SyncAtEndOpts.VariantsTasks, p.ResolveSyncVariantTasks(vts))
+func (p *Patch) AddSyncVariantsTasks(vts []VariantTasks, taskTags map[string][]string) error {
+	resolved := MergeVariantsTasks(p.SyncAtEndOpts.VariantsTasks, p.ResolveSyncVariantTasks(vts, taskTags))
 	syncVariantsTasksKey := bsonutil.GetDottedKeyName(SyncAtEndOptionsKey, SyncAtEndOptionsVariantsTasksKey)
 	if err := UpdateOne(
 		bson.M{IdKey: p.Id},
@@ -431,9 +576,9 @@ func (p *Patch) AddSyncVariantsTasks(vts []VariantTasks) error {
 }
 
 func (p *Patch) FindModule(moduleName string) *ModulePatch {
-	for _, module := range p.Patches {
+	for i, module := range p.Patches {
 		if module.ModuleName == moduleName {
-			return &module
+			return &p.Patches[i]
 		}
 	}
 	return nil
@@ -472,6 +617,73 @@ func (p *Patch) Insert() error {
 	return db.Insert(Collection, p)
 }
 
+// FilesSummary aggregates the additions, deletions, and number of files
+// changed across all of the patch's module patches. If the patch is stored
+// externally and hasn't been fetched yet, its summaries will be empty and
+// this returns zeros.
+func (p *Patch) FilesSummary() (additions, deletions, files int) {
+	for _, modulePatch := range p.Patches {
+		for _, summary := range modulePatch.PatchSet.Summary {
+			additions += summary.Additions
+			deletions += summary.Deletions
+			files++
+		}
+	}
+	return additions, deletions, files
+}
+
+// DetectMergeConflicts clones owner/repo, checks out the revision p was
+// based on (p.Githash), and does a dry run apply of each patch in
+// p.Patches, in order, on top of that real base revision, returning the
+// names of any files that fail to apply cleanly on top of the patches
+// applied before them. FetchPatchFiles must be called first so that
+// PatchSet.Patch is populated for each module patch.
+func (p *Patch) DetectMergeConflicts(ctx context.Context, settings *evergreen.Settings, owner, repo string) ([]string, error) {
+	dir, err := ioutil.TempDir("", "merge-conflict-check")
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating temporary directory")
+	}
+	defer os.RemoveAll(dir) //nolint: evg-lint
+
+	githubToken, err := settings.GetGithubOauthToken()
+	if err != nil {
+		return nil, errors.Wrap(err, "can't get github auth token")
+	}
+
+	cloneCmd := exec.CommandContext(ctx, "git", "clone", thirdparty.FormGitUrl("github.com", owner, repo, githubToken), dir)
+	if out, err := cloneCmd.CombinedOutput(); err != nil {
+		return nil, errors.Wrapf(err, "error cloning base repository: %s", out)
+	}
+
+	checkoutCmd := exec.CommandContext(ctx, "git", "checkout", p.Githash)
+	checkoutCmd.Dir = dir
+	if out, err := checkoutCmd.CombinedOutput(); err != nil {
+		return nil, errors.Wrapf(err, "error checking out base revision '%s': %s", p.Githash, out)
+	}
+
+	var conflicts []string
+	for _, modulePatch := range p.Patches {
+		if modulePatch.PatchSet.Patch == "" {
+			continue
+		}
+
+		fileConflicts, err := thirdparty.GitApplyCheck(dir, modulePatch.PatchSet.Patch)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error checking patch for module '%s'", modulePatch.ModuleName)
+		}
+		if len(fileConflicts) > 0 {
+			conflicts = append(conflicts, fileConflicts...)
+			continue
+		}
+
+		if err = thirdparty.GitApply(dir, modulePatch.PatchSet.Patch); err != nil {
+			return nil, errors.Wrapf(err, "error applying patch for module '%s'", modulePatch.ModuleName)
+		}
+	}
+
+	return conflicts, nil
+}
+
 // ConfigChanged looks through the parts of the patch and returns true if the
 // passed in remotePath is in the the name of the changed files that are part
 // of the patch
@@ -489,6 +701,26 @@ func (p *Patch) ConfigChanged(remotePath string) bool {
 	return false
 }
 
+// ContainsTask returns true if the given task is scheduled to run for the
+// given variant in the patch, either directly or as part of a display task.
+func (p *Patch) ContainsTask(variant, taskName string) bool {
+	for _, vt := range p.VariantsTasks {
+		if vt.Variant != variant {
+			continue
+		}
+		if utility.StringSliceContains(vt.Tasks, taskName) {
+			return true
+		}
+		for _, dt := range vt.DisplayTasks {
+			if dt.Name == taskName || utility.StringSliceContains(dt.ExecTasks, taskName) {
+				return true
+			}
+		}
+		return false
+	}
+	return false
+}
+
 // SetActivated sets the patch to activated in the db
 func (p *Patch) SetActivated(versionId string) error {
 	p.Version = versionId
@@ -615,16 +847,38 @@ func (p *Patch) GetRequester() string {
 }
 
 func (p *Patch) CanEnqueueToCommitQueue() bool {
+	canEnqueue, _ := p.CommitQueueEligibility()
+	return canEnqueue
+}
+
+// CommitQueueEligibility returns whether the patch can be enqueued to the
+// commit queue and, if not, a human-readable reason why.
+func (p *Patch) CommitQueueEligibility() (bool, string) {
 	for _, modulePatch := range p.Patches {
 		if !modulePatch.IsMbox {
-			return false
+			return false, fmt.Sprintf("module patch '%s' is not mbox format", modulePatch.ModuleName)
 		}
 	}
 
-	return true
+	return true, ""
 }
 
 func (p *Patch) MakeBackportDescription() (string, error) {
+	if len(p.BackportOf.PatchIDs) > 0 {
+		descriptions := make([]string, 0, len(p.BackportOf.PatchIDs))
+		for _, patchID := range p.BackportOf.PatchIDs {
+			commitQueuePatch, err := FindOneId(patchID)
+			if err != nil {
+				return "", errors.Wrap(err, "can't get patch being backported")
+			}
+			if commitQueuePatch == nil {
+				return "", errors.Errorf("patch '%s' being backported doesn't exist", patchID)
+			}
+			descriptions = append(descriptions, commitQueuePatch.Description)
+		}
+		return fmt.Sprintf(backportFmtString, strings.Join(descriptions, ", ")), nil
+	}
+
 	description := fmt.Sprintf("commit '%s'", p.BackportOf.SHA)
 	if len(p.BackportOf.PatchID) > 0 {
 		commitQueuePatch, err := FindOneId(p.BackportOf.PatchID)
@@ -733,6 +987,8 @@ func MakeNewMergePatch(pr *github.PullRequest, projectID, alias string) (*Patch,
 			BaseRepo:       pr.Base.Repo.GetName(),
 			BaseBranch:     pr.Base.GetRef(),
 			HeadHash:       pr.Head.GetSHA(),
+			HeadOwner:      pr.Head.GetUser().GetLogin(),
+			HeadRepo:       pr.Head.GetRepo().GetName(),
 		},
 	}
 
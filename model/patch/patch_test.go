@@ -1,6 +1,7 @@
 package patch
 
 import (
+	"context"
 	"path/filepath"
 	"sort"
 	"testing"
@@ -39,6 +40,222 @@ func TestConfigChanged(t *testing.T) {
 	assert.False(p.ConfigChanged(remoteConfigPath))
 }
 
+func TestContainsTask(t *testing.T) {
+	assert := assert.New(t)
+	p := &Patch{
+		VariantsTasks: []VariantTasks{
+			{
+				Variant: "bv1",
+				Tasks:   []string{"task1", "task2"},
+				DisplayTasks: []DisplayTask{{
+					Name:      "display1",
+					ExecTasks: []string{"exec1", "exec2"},
+				}},
+			},
+		},
+	}
+
+	assert.True(p.ContainsTask("bv1", "task1"))
+	assert.True(p.ContainsTask("bv1", "display1"))
+	assert.True(p.ContainsTask("bv1", "exec1"))
+	assert.False(p.ContainsTask("bv1", "task3"))
+	assert.False(p.ContainsTask("bv2", "task1"))
+}
+
+func TestAllTaskNamesAndAllVariantNames(t *testing.T) {
+	assert := assert.New(t)
+	p := &Patch{
+		VariantsTasks: []VariantTasks{
+			{
+				Variant: "bv1",
+				Tasks:   []string{"task1", "task2"},
+				DisplayTasks: []DisplayTask{{
+					Name:      "display1",
+					ExecTasks: []string{"exec1", "exec2"},
+				}},
+			},
+			{
+				Variant: "bv2",
+				Tasks:   []string{"task2", "task3"},
+			},
+		},
+	}
+
+	assert.ElementsMatch([]string{"bv1", "bv2"}, p.AllVariantNames())
+	assert.ElementsMatch([]string{"task1", "task2", "task3", "display1"}, p.AllTaskNames())
+}
+
+func TestCommitQueueEligibility(t *testing.T) {
+	assert := assert.New(t)
+
+	p := &Patch{
+		Patches: []ModulePatch{{ModuleName: "", IsMbox: true}},
+	}
+	ok, reason := p.CommitQueueEligibility()
+	assert.True(ok)
+	assert.Empty(reason)
+	assert.True(p.CanEnqueueToCommitQueue())
+
+	p.Patches = append(p.Patches, ModulePatch{ModuleName: "mod1", IsMbox: false})
+	ok, reason = p.CommitQueueEligibility()
+	assert.False(ok)
+	assert.Equal("module patch 'mod1' is not mbox format", reason)
+	assert.False(p.CanEnqueueToCommitQueue())
+}
+
+func TestFindModuleReturnsPointerIntoSlice(t *testing.T) {
+	assert := assert.New(t)
+	p := &Patch{
+		Patches: []ModulePatch{
+			{ModuleName: "mod1", Githash: "abc"},
+			{ModuleName: "mod2", Githash: "def"},
+		},
+	}
+
+	modulePatch := p.FindModule("mod1")
+	require.NotNil(t, modulePatch)
+	modulePatch.Githash = "changed"
+
+	assert.Equal("changed", p.Patches[0].Githash)
+}
+
+func TestMakeBackportDescription(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	require.NoError(db.ClearCollections(Collection))
+
+	patch1 := &Patch{Id: mgobson.NewObjectId(), Description: "fix the build"}
+	require.NoError(patch1.Insert())
+	patch2 := &Patch{Id: mgobson.NewObjectId(), Description: "fix the tests"}
+	require.NoError(patch2.Insert())
+
+	shaPatch := &Patch{BackportOf: BackportInfo{SHA: "abc123"}}
+	description, err := shaPatch.MakeBackportDescription()
+	assert.NoError(err)
+	assert.Equal("Backport: commit 'abc123'", description)
+
+	singlePatch := &Patch{BackportOf: BackportInfo{PatchID: patch1.Id.Hex()}}
+	description, err = singlePatch.MakeBackportDescription()
+	assert.NoError(err)
+	assert.Equal("Backport: fix the build", description)
+
+	multiPatch := &Patch{BackportOf: BackportInfo{PatchIDs: []string{patch1.Id.Hex(), patch2.Id.Hex()}}}
+	description, err = multiPatch.MakeBackportDescription()
+	assert.NoError(err)
+	assert.Equal("Backport: fix the build, fix the tests", description)
+}
+
+func TestFilesSummary(t *testing.T) {
+	assert := assert.New(t)
+
+	empty := &Patch{}
+	additions, deletions, files := empty.FilesSummary()
+	assert.Zero(additions)
+	assert.Zero(deletions)
+	assert.Zero(files)
+
+	p := &Patch{
+		Patches: []ModulePatch{
+			{
+				PatchSet: PatchSet{
+					Summary: []thirdparty.Summary{
+						{Name: "a.go", Additions: 3, Deletions: 1},
+						{Name: "b.go", Additions: 2, Deletions: 0},
+					},
+				},
+			},
+			{
+				PatchSet: PatchSet{
+					Summary: []thirdparty.Summary{
+						{Name: "c.go", Additions: 1, Deletions: 5},
+					},
+				},
+			},
+		},
+	}
+	additions, deletions, files = p.FilesSummary()
+	assert.Equal(6, additions)
+	assert.Equal(6, deletions)
+	assert.Equal(3, files)
+}
+
+func TestDetectMergeConflicts(t *testing.T) {
+	assert := assert.New(t)
+
+	config := testutil.TestConfig()
+	testutil.ConfigureIntegrationTest(t, config, "TestDetectMergeConflicts")
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	noConflicts := &Patch{
+		Githash: "master",
+		Patches: []ModulePatch{
+			{
+				PatchSet: PatchSet{
+					Patch: `diff --git a/test.txt b/test.txt
+new file mode 100644
+index 0000000..ce01362
+--- /dev/null
++++ b/test.txt
+@@ -0,0 +1 @@
++hello
+`,
+				},
+			},
+			{
+				ModuleName: "a-module",
+				PatchSet: PatchSet{
+					Patch: `diff --git a/test.txt b/test.txt
+index ce01362..94954ab 100644
+--- a/test.txt
++++ b/test.txt
+@@ -1 +1 @@
+-hello
++goodbye
+`,
+				},
+			},
+		},
+	}
+	conflicts, err := noConflicts.DetectMergeConflicts(ctx, config, "deafgoat", "mci-test")
+	assert.NoError(err)
+	assert.Empty(conflicts)
+
+	conflicting := &Patch{
+		Githash: "master",
+		Patches: []ModulePatch{
+			{
+				PatchSet: PatchSet{
+					Patch: `diff --git a/test.txt b/test.txt
+new file mode 100644
+index 0000000..ce01362
+--- /dev/null
++++ b/test.txt
+@@ -0,0 +1 @@
++hello
+`,
+				},
+			},
+			{
+				ModuleName: "a-module",
+				PatchSet: PatchSet{
+					Patch: `diff --git a/test.txt b/test.txt
+index abcdef0..94954ab 100644
+--- a/test.txt
++++ b/test.txt
+@@ -1 +1 @@
+-this does not match
++goodbye
+`,
+				},
+			},
+		},
+	}
+	conflicts, err = conflicting.DetectMergeConflicts(ctx, config, "deafgoat", "mci-test")
+	assert.NoError(err)
+	assert.Equal([]string{"test.txt"}, conflicts)
+}
+
 type patchSuite struct {
 	suite.Suite
 	testConfig *evergreen.Settings
@@ -155,6 +372,11 @@ func (s *patchSuite) TestMakeMergePatch() {
 		Base: &github.PullRequestBranch{
 			SHA: github.String("abcdef"),
 		},
+		Head: &github.PullRequestBranch{
+			SHA:  github.String("123456"),
+			User: &github.User{Login: github.String("octocat")},
+			Repo: &github.Repository{Name: github.String("evergreen")},
+		},
 		User: &github.User{
 			ID: github.Int64(1),
 		},
@@ -167,6 +389,9 @@ func (s *patchSuite) TestMakeMergePatch() {
 	s.Equal("mci", p.Project)
 	s.Equal(evergreen.PatchCreated, p.Status)
 	s.Equal(*pr.MergeCommitSHA, p.GithubPatchData.MergeCommitSHA)
+	s.Equal("octocat", p.GithubPatchData.HeadOwner)
+	s.Equal("evergreen", p.GithubPatchData.HeadRepo)
+	s.True(p.IsGithubPRPatch())
 }
 
 func (s *patchSuite) TestUpdateGithashProjectAndTasks() {
@@ -210,6 +435,75 @@ func (s *patchSuite) TestUpdateGithashProjectAndTasks() {
 	s.Equal("variant1", dbPatch.VariantsTasks[0].Variant)
 }
 
+func (s *patchSuite) TestSetAlias() {
+	// The suite's patches are all GitHub PR or PR merge patches, whose
+	// requester doesn't depend on the alias, so use a plain CLI patch.
+	patch := &Patch{Id: mgobson.NewObjectId(), Author: "octocat"}
+	s.NoError(patch.Insert())
+	s.Empty(patch.Alias)
+	s.Equal(evergreen.PatchVersionRequester, patch.GetRequester())
+
+	s.NoError(patch.SetAlias(evergreen.CommitQueueAlias))
+	s.Equal(evergreen.CommitQueueAlias, patch.Alias)
+	s.Equal(evergreen.MergeTestRequester, patch.GetRequester())
+
+	dbPatch, err := FindOne(ById(patch.Id))
+	s.NoError(err)
+	s.Equal(evergreen.CommitQueueAlias, dbPatch.Alias)
+	s.Equal(evergreen.MergeTestRequester, dbPatch.GetRequester())
+}
+
+func (s *patchSuite) TestSetStartAndFinishTime() {
+	patch, err := FindOne(ByUserAndCommitQueue("octocat", false))
+	s.NoError(err)
+
+	// The database stores times with millisecond precision.
+	startTime := s.time.Add(-time.Hour).Round(time.Millisecond)
+	s.NoError(patch.SetStartTime(startTime))
+	s.True(startTime.Equal(patch.StartTime))
+
+	finishTime := s.time.Add(time.Hour).Round(time.Millisecond)
+	s.NoError(patch.SetFinishTime(finishTime))
+	s.True(finishTime.Equal(patch.FinishTime))
+
+	dbPatch, err := FindOne(ById(patch.Id))
+	s.NoError(err)
+	s.True(startTime.Equal(dbPatch.StartTime))
+	s.True(finishTime.Equal(dbPatch.FinishTime))
+}
+
+func (s *patchSuite) TestAddParameters() {
+	patch, err := FindOne(ByUserAndCommitQueue("octocat", false))
+	s.NoError(err)
+	s.Empty(patch.Parameters)
+
+	s.NoError(patch.AddParameters([]Parameter{
+		{Key: "key1", Value: "value1"},
+		{Key: "key2", Value: "value2"},
+	}))
+	s.ElementsMatch([]Parameter{
+		{Key: "key1", Value: "value1"},
+		{Key: "key2", Value: "value2"},
+	}, patch.Parameters)
+
+	// Replaces existing keys, appends new ones, and collapses duplicate keys
+	// within the input to the last value.
+	s.NoError(patch.AddParameters([]Parameter{
+		{Key: "key1", Value: "updated1"},
+		{Key: "key3", Value: "first3"},
+		{Key: "key3", Value: "second3"},
+	}))
+	s.ElementsMatch([]Parameter{
+		{Key: "key1", Value: "updated1"},
+		{Key: "key2", Value: "value2"},
+		{Key: "key3", Value: "second3"},
+	}, patch.Parameters)
+
+	dbPatch, err := FindOne(ById(patch.Id))
+	s.NoError(err)
+	s.ElementsMatch(patch.Parameters, dbPatch.Parameters)
+}
+
 func TestPatchSortByCreateTime(t *testing.T) {
 	assert := assert.New(t)
 	patches := PatchesByCreateTime{
@@ -474,13 +768,38 @@ func TestResolveSyncVariantsTasks(t *testing.T) {
 					Tasks:         testCase.tasks,
 				},
 			}
-			actual := p.ResolveSyncVariantTasks(testCase.vts)
+			actual := p.ResolveSyncVariantTasks(testCase.vts, nil)
 			assert.Len(t, actual, len(testCase.expected))
 			checkEqualVTs(t, testCase.expected, actual)
 		})
 	}
 }
 
+func TestResolveSyncVariantTasksWithTagSelector(t *testing.T) {
+	p := &Patch{
+		SyncAtEndOpts: SyncAtEndOptions{
+			BuildVariants: []string{"bv1"},
+			Tasks:         []string{".mytag"},
+		},
+	}
+	vts := []VariantTasks{
+		{
+			Variant: "bv1",
+			Tasks:   []string{"t1", "t2", "t3"},
+		},
+	}
+	taskTags := map[string][]string{
+		"t1": {"mytag"},
+		"t2": {"mytag", "othertag"},
+		"t3": {"othertag"},
+	}
+
+	actual := p.ResolveSyncVariantTasks(vts, taskTags)
+	require.Len(t, actual, 1)
+	assert.Equal(t, "bv1", actual[0].Variant)
+	assert.ElementsMatch(t, []string{"t1", "t2"}, actual[0].Tasks)
+}
+
 func TestAddSyncVariantsTasks(t *testing.T) {
 	for testName, testCase := range map[string]struct {
 		syncBVs         []string
@@ -604,7 +923,7 @@ func TestAddSyncVariantsTasks(t *testing.T) {
 			}
 			require.NoError(t, p.Insert())
 
-			require.NoError(t, p.AddSyncVariantsTasks(testCase.newVTs))
+			require.NoError(t, p.AddSyncVariantsTasks(testCase.newVTs, nil))
 			dbPatch, err := FindOne(ById(p.Id))
 			require.NoError(t, err)
 			checkEqualVTs(t, testCase.expectedSyncVTs, dbPatch.SyncAtEndOpts.VariantsTasks)
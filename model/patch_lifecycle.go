@@ -573,8 +573,8 @@ func (e *EnqueuePatch) Valid() bool {
 
 func MakeMergePatchFromExisting(existingPatch *patch.Patch) (*patch.Patch, error) {
 	// verify the patch and its modules are in mbox format
-	if !existingPatch.CanEnqueueToCommitQueue() {
-		return nil, errors.Errorf("can't enqueue non-mbox patch '%s'", existingPatch.Id.Hex())
+	if ok, reason := existingPatch.CommitQueueEligibility(); !ok {
+		return nil, errors.Errorf("can't enqueue patch '%s': %s", existingPatch.Id.Hex(), reason)
 	}
 
 	// verify the commit queue is on
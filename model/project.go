@@ -1359,6 +1359,16 @@ func (p *Project) GetAllVariantTasks() []patch.VariantTasks {
 	return vts
 }
 
+// TaskTagsByName returns a mapping of task name to the tags defined on that
+// task, for use in resolving tag selectors against literal task names.
+func (p *Project) TaskTagsByName() map[string][]string {
+	taskTags := map[string][]string{}
+	for _, t := range p.Tasks {
+		taskTags[t.Name] = t.Tags
+	}
+	return taskTags
+}
+
 // TasksThatCallCommand returns a map of tasks that call a given command to the
 // number of times the command is called in the task.
 func (p *Project) TasksThatCallCommand(find string) map[string]int {
@@ -118,6 +118,23 @@ func ProjectEventsBefore(id string, before time.Time, n int) (ProjectChangeEvent
 	return events, err
 }
 
+// ProjectEventsInWindow returns up to n project events for id that occurred in the
+// half-open window [after, before).
+func ProjectEventsInWindow(id string, after, before time.Time, n int) (ProjectChangeEvents, error) {
+	filter := event.ResourceTypeKeyIs(EventResourceTypeProject)
+	filter[event.ResourceIdKey] = id
+	filter[event.TimestampKey] = bson.M{
+		"$lt":  before,
+		"$gte": after,
+	}
+
+	query := db.Query(filter).Sort([]string{"-" + event.TimestampKey}).Limit(n)
+	events := ProjectChangeEvents{}
+	err := db.FindAllQ(event.AllLogCollection, query, &events)
+
+	return events, err
+}
+
 func LogProjectEvent(eventType string, projectId string, eventData ProjectChangeEvent) error {
 	projectEvent := event.EventLogEntry{
 		Timestamp:    time.Now(),
@@ -295,7 +295,7 @@ func (p *ProjectRef) AddToRepoScope(user *user.DBUser) error {
 		if err := user.AddRole(repoRole); err != nil {
 			return errors.Wrapf(err, "error adding admin role to repo '%s'", user.Username())
 		}
-		if err := addAdminToRepo(p.RepoRefId, user.Username()); err != nil {
+		if err := AddAdminToRepo(p.RepoRefId, user.Username()); err != nil {
 			return errors.Wrapf(err, "error adding user as repo admin")
 		}
 	}
@@ -646,12 +646,14 @@ func FindOneProjectRefByRepoAndBranchWithPRTesting(owner, repo, branch string) (
 	return &projectRefs[target], nil
 }
 
-// FindOneProjectRef finds the project ref for this owner/repo/branch that has the commit queue enabled.
-// There should only ever be one project for the query because we only enable commit queue if
-// no other project ref with the same specification has it enabled.
+// FindOneProjectRefWithCommitQueueByOwnerRepoAndBranch finds the project ref for this
+// owner/repo/branch that has the commit queue enabled. There should only ever be one
+// project for the query because we only enable commit queue if no other project ref
+// with the same specification has it enabled, but if that invariant is ever violated,
+// this returns an error rather than silently picking one of the matches.
 func FindOneProjectRefWithCommitQueueByOwnerRepoAndBranch(owner, repo, branch string) (*ProjectRef, error) {
-	projectRef := &ProjectRef{}
-	err := db.FindOne(
+	projectRefs := []ProjectRef{}
+	err := db.FindAll(
 		ProjectRefCollection,
 		bson.M{
 			ProjectRefOwnerKey:  owner,
@@ -661,18 +663,24 @@ func FindOneProjectRefWithCommitQueueByOwnerRepoAndBranch(owner, repo, branch st
 		},
 		db.NoProjection,
 		db.NoSort,
-		projectRef,
+		db.NoSkip,
+		db.NoLimit,
+		&projectRefs,
 	)
-	if adb.ResultsNotFound(err) {
-		return nil, nil
-	}
 	if err != nil {
 		return nil, errors.Wrapf(err, "can't query for project with commit queue. owner: %s, repo: %s, branch: %s", owner, repo, branch)
 	}
+	if len(projectRefs) == 0 {
+		return nil, nil
+	}
+	if len(projectRefs) > 1 {
+		return nil, errors.Errorf("found %d project refs with commit queue enabled for owner '%s' repo '%s' branch '%s', when only 1 was expected",
+			len(projectRefs), owner, repo, branch)
+	}
 
-	projectRef.checkDefaultLogger()
+	projectRefs[0].checkDefaultLogger()
 
-	return projectRef, nil
+	return &projectRefs[0], nil
 }
 
 func FindMergedEnabledProjectRefsByOwnerAndRepo(owner, repo string) ([]ProjectRef, error) {
@@ -754,8 +762,8 @@ func FindPeriodicProjects() ([]ProjectRef, error) {
 }
 
 // FindProjectRefs returns limit refs starting at project identifier key
-// in the sortDir direction
-func FindProjectRefs(key string, limit int, sortDir int) ([]ProjectRef, error) {
+// in the sortDir direction. If onlyEnabled is true, disabled projects are excluded.
+func FindProjectRefs(key string, limit int, sortDir int, onlyEnabled bool) ([]ProjectRef, error) {
 	projectRefs := []ProjectRef{}
 	filter := bson.M{}
 	sortSpec := ProjectRefIdKey
@@ -766,6 +774,9 @@ func FindProjectRefs(key string, limit int, sortDir int) ([]ProjectRef, error) {
 	} else {
 		filter[ProjectRefIdKey] = bson.M{"$gte": key}
 	}
+	if onlyEnabled {
+		filter[ProjectRefEnabledKey] = true
+	}
 
 	err := db.FindAll(
 		ProjectRefCollection,
@@ -353,6 +353,22 @@ func TestFindOneProjectRefWithCommitQueueByOwnerRepoAndBranch(t *testing.T) {
 	assert.NotNil(projectRef)
 	assert.Equal("mci", projectRef.Id)
 	assert.Equal("buildlogger", projectRef.DefaultLogger)
+
+	doc2 := &ProjectRef{
+		Owner:  "mongodb",
+		Repo:   "mci",
+		Branch: "master",
+		Id:     "mci2",
+		CommitQueue: CommitQueueParams{
+			Enabled: true,
+		},
+	}
+	require.NoError(doc2.Insert())
+
+	projectRef, err = FindOneProjectRefWithCommitQueueByOwnerRepoAndBranch("mongodb", "mci", "master")
+	assert.Error(err)
+	assert.Contains(err.Error(), "found 2 project refs with commit queue enabled")
+	assert.Nil(projectRef)
 }
 
 func TestCanEnableCommitQueue(t *testing.T) {
@@ -1,6 +1,8 @@
 package model
 
 import (
+	"sort"
+
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/mongodb/anser/bsonutil"
 	adb "github.com/mongodb/anser/db"
@@ -19,6 +21,12 @@ const (
 	ProjectVarsCollection = "project_vars"
 	ProjectAWSSSHKeyName  = "__project_aws_ssh_key_name"
 	ProjectAWSSSHKeyValue = "__project_aws_ssh_key_value"
+
+	// MaxVarValueSize is the maximum size, in bytes, allowed for a single project var value.
+	MaxVarValueSize = 16 * 1024
+	// MaxVarsTotalSize is the maximum combined size, in bytes, allowed across all of a
+	// project's var values.
+	MaxVarsTotalSize = 1024 * 1024
 )
 
 //ProjectVars holds a map of variables specific to a given project.
@@ -270,3 +278,27 @@ func (projectVars *ProjectVars) RedactPrivateVars() *ProjectVars {
 	}
 	return res
 }
+
+// RedactedCopy returns a copy of projectVars with the values of any private
+// vars blanked out, without touching the database. It lets callers that
+// already hold a ProjectVars get a redacted version locally rather than needing another
+// round-trip through FindProjectVarsById.
+func (projectVars *ProjectVars) RedactedCopy() *ProjectVars {
+	return projectVars.RedactPrivateVars()
+}
+
+// ValidateVarSizes checks vars against MaxVarValueSize and MaxVarsTotalSize. It returns
+// the sorted keys of any individually oversized values, and whether the combined size of
+// all values is too large.
+func ValidateVarSizes(vars map[string]string) (oversizedKeys []string, totalTooLarge bool) {
+	var total int
+	for k, v := range vars {
+		total += len(v)
+		if len(v) > MaxVarValueSize {
+			oversizedKeys = append(oversizedKeys, k)
+		}
+	}
+	sort.Strings(oversizedKeys)
+
+	return oversizedKeys, total > MaxVarsTotalSize
+}
@@ -204,6 +204,27 @@ func TestRedactPrivateVars(t *testing.T) {
 	assert.NotEqual("", projectVars.Vars["a"], "original vars should not be modified")
 }
 
+func TestRedactedCopy(t *testing.T) {
+	assert := assert.New(t)
+
+	vars := map[string]string{
+		"a": "a",
+		"b": "b",
+	}
+	privateVars := map[string]bool{
+		"a": true,
+	}
+	projectVars := &ProjectVars{
+		Id:          "mongodb",
+		Vars:        vars,
+		PrivateVars: privateVars,
+	}
+	redacted := projectVars.RedactedCopy()
+	assert.Equal("", redacted.Vars["a"], "private variables should be blanked out in the copy")
+	assert.Equal("b", redacted.Vars["b"], "non-private variables should be unchanged in the copy")
+	assert.Equal("a", projectVars.Vars["a"], "the original should be untouched by taking a redacted copy")
+}
+
 func TestAWSVars(t *testing.T) {
 	require := require.New(t)
 	require.NoError(db.ClearCollections(ProjectVarsCollection, ProjectRefCollection))
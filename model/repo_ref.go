@@ -88,6 +88,37 @@ func (r *RepoRef) Upsert() error {
 	return err
 }
 
+// Remove deletes the repo ref, detaches the repo admin role from its admins,
+// and cleans up the scope and role created for it by AddPermissions.
+func (r *RepoRef) Remove() error {
+	if err := db.Remove(RepoRefCollection, bson.M{RepoRefIdKey: r.Id}); err != nil {
+		return errors.Wrapf(err, "error removing repo ref '%s'", r.Id)
+	}
+
+	repoRole := GetRepoRole(r.Id)
+	for _, admin := range r.Admins {
+		u, err := user.FindOneById(admin)
+		if err != nil {
+			return errors.Wrapf(err, "error finding user '%s'", admin)
+		}
+		if u == nil {
+			continue
+		}
+		if err = u.RemoveRole(repoRole); err != nil {
+			return errors.Wrapf(err, "error removing repo admin role from user '%s'", admin)
+		}
+	}
+
+	rm := evergreen.GetEnvironment().RoleManager()
+	if err := rm.DeleteRole(repoRole); err != nil {
+		return errors.Wrapf(err, "error deleting role for repo project '%s'", r.Id)
+	}
+	if err := rm.DeleteScope(gimlet.Scope{ID: GetRepoScope(r.Id)}); err != nil {
+		return errors.Wrapf(err, "error deleting scope for repo project '%s'", r.Id)
+	}
+	return nil
+}
+
 // findOneRepoRefQ returns one RepoRef that satisfies the query.
 func findOneRepoRefQ(query db.Q) (*RepoRef, error) {
 	repoRef := &RepoRef{}
@@ -115,6 +146,15 @@ func FindRepoRefByOwnerAndRepo(owner, repoName string) (*RepoRef, error) {
 	}))
 }
 
+// FindRepoRefsByOwner returns all repo-level configs owned by the given org.
+func FindRepoRefsByOwner(owner string) ([]RepoRef, error) {
+	repoRefs := []RepoRef{}
+	err := db.FindAllQ(RepoRefCollection, db.Query(bson.M{
+		RepoRefOwnerKey: owner,
+	}), &repoRefs)
+	return repoRefs, err
+}
+
 func (r *RepoRef) AddPermissions(creator *user.DBUser) error {
 	rm := evergreen.GetEnvironment().RoleManager()
 
@@ -148,7 +188,8 @@ func (r *RepoRef) AddPermissions(creator *user.DBUser) error {
 	return nil
 }
 
-func addAdminToRepo(repoId, admin string) error {
+// AddAdminToRepo adds the given user to the repo ref's list of admins.
+func AddAdminToRepo(repoId, admin string) error {
 	return db.UpdateId(
 		RepoRefCollection,
 		repoId,
@@ -158,6 +199,29 @@ func addAdminToRepo(repoId, admin string) error {
 	)
 }
 
+// RemoveAdminFromRepo removes the given user from the repo ref's list of
+// admins and revokes the repo admin role from them.
+func RemoveAdminFromRepo(repoId, admin string) error {
+	if err := db.UpdateId(
+		RepoRefCollection,
+		repoId,
+		bson.M{
+			"$pull": bson.M{RepoRefAdminsKey: admin},
+		},
+	); err != nil {
+		return errors.Wrapf(err, "error removing admin '%s' from repo '%s'", admin, repoId)
+	}
+
+	u, err := user.FindOneById(admin)
+	if err != nil {
+		return errors.Wrapf(err, "error finding user '%s'", admin)
+	}
+	if u == nil {
+		return nil
+	}
+	return errors.Wrapf(u.RemoveRole(GetRepoRole(repoId)), "error removing repo admin role from user '%s'", admin)
+}
+
 func GetRepoScope(repoId string) string {
 	return fmt.Sprintf("repo_%s", repoId)
 }
@@ -0,0 +1,91 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddAndRemoveAdminFromRepo(t *testing.T) {
+	require.NoError(t, db.ClearCollections(user.Collection, RepoRefCollection, evergreen.ScopeCollection, evergreen.RoleCollection))
+	_ = evergreen.GetEnvironment().DB().RunCommand(nil, map[string]string{"create": evergreen.ScopeCollection})
+	u := user.DBUser{
+		Id: "me",
+	}
+	require.NoError(t, u.Insert())
+	r := RepoRef{ProjectRef: ProjectRef{
+		Id: "myRepo",
+	}}
+	require.NoError(t, r.Add(&u))
+
+	dbUser, err := user.FindOneById(u.Id)
+	require.NoError(t, err)
+	assert.Contains(t, dbUser.Roles(), GetRepoRole(r.Id))
+
+	assert.NoError(t, RemoveAdminFromRepo(r.Id, u.Id))
+	dbUser, err = user.FindOneById(u.Id)
+	require.NoError(t, err)
+	assert.NotContains(t, dbUser.Roles(), GetRepoRole(r.Id))
+
+	dbRepoRef, err := FindOneRepoRef(r.Id)
+	require.NoError(t, err)
+	assert.NotContains(t, dbRepoRef.Admins, u.Id)
+}
+
+func TestRepoRefRemoveDetachesAdminRole(t *testing.T) {
+	require.NoError(t, db.ClearCollections(user.Collection, RepoRefCollection, evergreen.ScopeCollection, evergreen.RoleCollection))
+	_ = evergreen.GetEnvironment().DB().RunCommand(nil, map[string]string{"create": evergreen.ScopeCollection})
+	u := user.DBUser{
+		Id: "me",
+	}
+	require.NoError(t, u.Insert())
+	r := RepoRef{ProjectRef: ProjectRef{
+		Id:     "myRepo",
+		Admins: []string{u.Id},
+	}}
+	require.NoError(t, r.Add(&u))
+
+	dbUser, err := user.FindOneById(u.Id)
+	require.NoError(t, err)
+	assert.Contains(t, dbUser.Roles(), GetRepoRole(r.Id))
+
+	require.NoError(t, r.Remove())
+
+	dbUser, err = user.FindOneById(u.Id)
+	require.NoError(t, err)
+	assert.NotContains(t, dbUser.Roles(), GetRepoRole(r.Id))
+}
+
+func TestFindRepoRefsByOwner(t *testing.T) {
+	require.NoError(t, db.Clear(RepoRefCollection))
+	repo1 := RepoRef{ProjectRef: ProjectRef{
+		Id:    "repo1",
+		Owner: "evergreen-ci",
+		Repo:  "evergreen",
+	}}
+	require.NoError(t, repo1.Insert())
+	repo2 := RepoRef{ProjectRef: ProjectRef{
+		Id:    "repo2",
+		Owner: "evergreen-ci",
+		Repo:  "gimlet",
+	}}
+	require.NoError(t, repo2.Insert())
+	otherOwner := RepoRef{ProjectRef: ProjectRef{
+		Id:    "repo3",
+		Owner: "mongodb",
+		Repo:  "mongo",
+	}}
+	require.NoError(t, otherOwner.Insert())
+
+	repoRefs, err := FindRepoRefsByOwner("evergreen-ci")
+	require.NoError(t, err)
+	assert.Len(t, repoRefs, 2)
+
+	repoRefs, err = FindRepoRefsByOwner("nonexistent")
+	require.NoError(t, err)
+	assert.Empty(t, repoRefs)
+}
@@ -511,7 +511,8 @@ func ByRecentlyFinished(finishTime time.Time, project string, requester string)
 
 // Returns query which targets list of tasks
 // And allow filter by project_id, status, start_time (gte), finish_time (lte)
-func WithinTimePeriod(startedAfter, finishedBefore time.Time, project string, statuses []string) db.Q {
+// and a task id cursor (gte), for paging through large result sets.
+func WithinTimePeriod(startedAfter, finishedBefore time.Time, project string, statuses []string, startTaskId string) db.Q {
 	q := []bson.M{}
 
 	if !startedAfter.IsZero() {
@@ -547,9 +548,18 @@ func WithinTimePeriod(startedAfter, finishedBefore time.Time, project string, st
 		})
 	}
 
+	// Filter by task id cursor, for paging
+	if startTaskId != "" {
+		q = append(q, bson.M{
+			IdKey: bson.M{
+				"$gte": startTaskId,
+			},
+		})
+	}
+
 	return db.Query(bson.M{
 		"$and": q,
-	})
+	}).Sort([]string{"+" + IdKey})
 }
 
 func ByExecutionTask(taskId string) db.Q {
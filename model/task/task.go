@@ -327,6 +327,7 @@ type AbortInfo struct {
 	TaskID     string `bson:"task_id,omitempty" json:"task_id,omitempty"`
 	NewVersion string `bson:"new_version,omitempty" json:"new_version,omitempty"`
 	PRClosed   bool   `bson:"pr_closed,omitempty" json:"pr_closed,omitempty"`
+	Reason     string `bson:"reason,omitempty" json:"reason,omitempty"`
 }
 
 var (
@@ -949,7 +949,7 @@ func TestWithinTimePeriodProjectFilter(t *testing.T) {
 		assert.NoError(taskDoc.Insert())
 	}
 
-	tasks, err := Find(WithinTimePeriod(time.Time{}, time.Time{}, "proj", []string{}))
+	tasks, err := Find(WithinTimePeriod(time.Time{}, time.Time{}, "proj", []string{}, ""))
 	assert.NoError(err)
 	assert.Len(tasks, 1)
 	assert.Equal(tasks[0].Id, "task1")
@@ -982,7 +982,7 @@ func TestWithinTimePeriodDatesFilter(t *testing.T) {
 	}
 
 	tasks, err := Find(WithinTimePeriod(
-		time.Now().AddDate(0, 0, -4), time.Now().AddDate(0, 0, -1), "", []string{}))
+		time.Now().AddDate(0, 0, -4), time.Now().AddDate(0, 0, -1), "", []string{}, ""))
 	assert.NoError(err)
 	assert.Len(tasks, 1)
 	assert.Equal(tasks[0].Id, "task2")
@@ -1013,12 +1013,33 @@ func TestWithinTimePeriodStatusesFilter(t *testing.T) {
 
 	statuses := []string{"A", "B"}
 
-	tasks, err := Find(WithinTimePeriod(time.Time{}, time.Time{}, "", statuses))
+	tasks, err := Find(WithinTimePeriod(time.Time{}, time.Time{}, "", statuses, ""))
 	assert.NoError(err)
 	assert.Len(tasks, 2)
 	assert.Subset([]string{tasks[0].Status, tasks[1].Status}, statuses)
 }
 
+func TestWithinTimePeriodStartTaskIdFilter(t *testing.T) {
+	assert := assert.New(t)
+	assert.NoError(db.ClearCollections(Collection, OldCollection))
+
+	taskDocs := []Task{
+		{Id: "task1"},
+		{Id: "task2"},
+		{Id: "task3"},
+	}
+
+	for _, taskDoc := range taskDocs {
+		assert.NoError(taskDoc.Insert())
+	}
+
+	tasks, err := Find(WithinTimePeriod(time.Time{}, time.Time{}, "", []string{}, "task2"))
+	assert.NoError(err)
+	assert.Len(tasks, 2)
+	assert.Equal("task2", tasks[0].Id)
+	assert.Equal("task3", tasks[1].Id)
+}
+
 func TestTaskStatusCount(t *testing.T) {
 	assert := assert.New(t)
 	counts := TaskStatusCount{}
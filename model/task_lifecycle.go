@@ -284,14 +284,16 @@ func TryResetTask(taskId, user, origin string, detail *apimodels.TaskEndDetail)
 	return errors.WithStack(resetTask(t.Id, caller, false))
 }
 
-func AbortTask(taskId, caller string) error {
+// AbortTask aborts the task matching the given task ID. The reason, if set,
+// is recorded on the task's AbortInfo so the abort is auditable.
+func AbortTask(taskId, caller, reason string) error {
 	t, err := task.FindOne(task.ById(taskId))
 	if err != nil {
 		return err
 	}
 	if t.DisplayOnly {
 		for _, et := range t.ExecutionTasks {
-			_ = AbortTask(et, caller) // discard errors because some execution tasks may not be abortable
+			_ = AbortTask(et, caller, reason) // discard errors because some execution tasks may not be abortable
 		}
 	}
 
@@ -305,7 +307,7 @@ func AbortTask(taskId, caller string) error {
 		return err
 	}
 	event.LogTaskAbortRequest(t.Id, t.Execution, caller)
-	return t.SetAborted(task.AbortInfo{User: caller})
+	return t.SetAborted(task.AbortInfo{User: caller, Reason: reason})
 }
 
 // Deactivate any previously activated but undispatched
@@ -1380,14 +1380,14 @@ func TestAbortTask(t *testing.T) {
 		So(finishedTask.Insert(), ShouldBeNil)
 		var err error
 		Convey("with a task that has started, aborting a task should work", func() {
-			So(AbortTask(testTask.Id, userName), ShouldBeNil)
+			So(AbortTask(testTask.Id, userName, ""), ShouldBeNil)
 			testTask, err = task.FindOne(task.ById(testTask.Id))
 			So(err, ShouldBeNil)
 			So(testTask.Activated, ShouldEqual, false)
 			So(testTask.Aborted, ShouldEqual, true)
 		})
 		Convey("a task that is finished should error when aborting", func() {
-			So(AbortTask(finishedTask.Id, userName), ShouldNotBeNil)
+			So(AbortTask(finishedTask.Id, userName, ""), ShouldNotBeNil)
 		})
 		Convey("a display task should abort its execution tasks", func() {
 			dt := task.Task{
@@ -1411,7 +1411,7 @@ func TestAbortTask(t *testing.T) {
 			}
 			So(et2.Insert(), ShouldBeNil)
 
-			So(AbortTask(dt.Id, userName), ShouldBeNil)
+			So(AbortTask(dt.Id, userName, ""), ShouldBeNil)
 			dbTask, err := task.FindOneId(dt.Id)
 			So(err, ShouldBeNil)
 			So(dbTask.Aborted, ShouldBeTrue)
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -73,14 +74,34 @@ func FindOneTestLog(name, task string, execution int) (*TestLog, error) {
 	return tl, errors.WithStack(err)
 }
 
+// FindTestLogsByTask returns all test logs for the given task execution,
+// sorted by name.
+func FindTestLogsByTask(task string, execution int) ([]TestLog, error) {
+	logs := []TestLog{}
+	err := db.FindAll(
+		TestLogCollection,
+		bson.M{
+			TestLogTaskKey:          task,
+			TestLogTaskExecutionKey: execution,
+		},
+		db.NoProjection,
+		[]string{TestLogNameKey},
+		db.NoSkip,
+		db.NoLimit,
+		&logs,
+	)
+	return logs, errors.WithStack(err)
+}
+
 func DeleteTestLogsWithLimit(ctx context.Context, env evergreen.Environment, ts time.Time, limit int) (int, error) {
 	if limit > 100*1000 {
 		panic("cannot delete more than 100k documents in a single operation")
 	}
 
+	filter := bson.M{"_id": bson.M{"$lt": primitive.NewObjectIDFromTimestamp(ts).Hex()}}
 	ops := make([]mongo.WriteModel, limit)
 	for idx := 0; idx < limit; idx++ {
-		ops[idx] = mongo.NewDeleteOneModel().SetFilter(bson.M{"_id": bson.M{"$lt": primitive.NewObjectIDFromTimestamp(ts).Hex()}})
+		ops[idx] = mongo.NewDeleteOneModel().SetFilter(filter)
 	}
 
 	res, err := env.DB().Collection(TestLogCollection).BulkWrite(ctx, ops, options.BulkWrite().SetOrdered(false))
@@ -100,6 +121,62 @@ func (self *TestLog) Insert() error {
 	return errors.WithStack(db.Insert(TestLogCollection, self))
 }
 
+// InsertManyTestLogs validates each of the given logs, assigns them IDs,
+// and inserts them in a single bulk write. If any log fails validation,
+// none of the logs are inserted or mutated.
+func InsertManyTestLogs(logs []*TestLog) error {
+	for _, log := range logs {
+		if err := log.Validate(); err != nil {
+			return errors.Wrapf(err, "cannot insert invalid test log '%s'", log.Name)
+		}
+	}
+
+	docs := make([]interface{}, 0, len(logs))
+	for _, log := range logs {
+		log.Id = mgobson.NewObjectId().Hex()
+		docs = append(docs, log)
+	}
+	return errors.WithStack(db.InsertMany(TestLogCollection, docs...))
+}
+
+// AppendLines appends the given lines to the test log, creating the
+// underlying document if it doesn't already exist. Name and Task must be
+// set before the first append.
+func (self *TestLog) AppendLines(lines []string) error {
+	if self.Name == "" {
+		return errors.New("test log requires a 'Name' field")
+	}
+	if self.Task == "" {
+		return errors.New("test log requires a 'Task' field")
+	}
+	if self.Id == "" {
+		self.Id = mgobson.NewObjectId().Hex()
+	}
+
+	_, err := db.Upsert(
+		TestLogCollection,
+		bson.M{
+			TestLogIdKey: self.Id,
+		},
+		bson.M{
+			"$push": bson.M{
+				TestLogLinesKey: bson.M{"$each": lines},
+			},
+			"$setOnInsert": bson.M{
+				TestLogIdKey:            self.Id,
+				TestLogNameKey:          self.Name,
+				TestLogTaskKey:          self.Task,
+				TestLogTaskExecutionKey: self.TaskExecution,
+			},
+		},
+	)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	self.Lines = append(self.Lines, lines...)
+	return nil
+}
+
 // Validate makes sure the log will accessible in the database
 // before the log itself is inserted. Returns an error if
 // something is wrong.
@@ -115,11 +192,12 @@ func (self *TestLog) Validate() error {
 }
 
 // URL returns the path to access the log based on its current fields.
-// Does not error if fields are not set.
+// Does not error if fields are not set. Each path segment is URL-escaped
+// so names containing slashes or spaces don't break the resulting link.
 func (self *TestLog) URL() string {
 	return fmt.Sprintf("/test_log/%v/%v/%v",
-		self.Task,
+		url.PathEscape(self.Task),
 		self.TaskExecution,
-		self.Name,
+		url.PathEscape(self.Name),
 	)
 }
@@ -53,6 +53,110 @@ func TestTestLogInsertAndFind(t *testing.T) {
 
 }
 
+func TestTestLogAppendLines(t *testing.T) {
+	require.NoError(t, db.Clear(TestLogCollection), "error clearing test log collection")
+
+	t.Run("CreatesDocIfAbsent", func(t *testing.T) {
+		require.NoError(t, db.Clear(TestLogCollection))
+		log := &TestLog{
+			Name:          "TestStreaming",
+			Task:          "task1",
+			TaskExecution: 1,
+		}
+		assert.NoError(t, log.AppendLines([]string{"line1", "line2"}))
+		require.NotEmpty(t, log.Id)
+
+		fromDB, err := FindOneTestLogById(log.Id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"line1", "line2"}, fromDB.Lines)
+
+		assert.NoError(t, log.AppendLines([]string{"line3"}))
+		fromDB, err = FindOneTestLogById(log.Id)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"line1", "line2", "line3"}, fromDB.Lines)
+	})
+
+	t.Run("RequiresNameAndTask", func(t *testing.T) {
+		log := &TestLog{Task: "task1"}
+		assert.Error(t, log.AppendLines([]string{"line1"}))
+
+		log = &TestLog{Name: "TestStreaming"}
+		assert.Error(t, log.AppendLines([]string{"line1"}))
+	})
+}
+
+func TestInsertManyTestLogs(t *testing.T) {
+	require.NoError(t, db.Clear(TestLogCollection), "error clearing test log collection")
+
+	t.Run("Success", func(t *testing.T) {
+		require.NoError(t, db.Clear(TestLogCollection))
+		logs := []*TestLog{
+			{Name: "test1", Task: "task1", TaskExecution: 0, Lines: []string{"a"}},
+			{Name: "test2", Task: "task1", TaskExecution: 0, Lines: []string{"b"}},
+		}
+		assert.NoError(t, InsertManyTestLogs(logs))
+		for _, log := range logs {
+			assert.NotEmpty(t, log.Id)
+			fromDB, err := FindOneTestLogById(log.Id)
+			require.NoError(t, err)
+			assert.Equal(t, log.Name, fromDB.Name)
+		}
+	})
+
+	t.Run("RejectsInvalidLog", func(t *testing.T) {
+		require.NoError(t, db.Clear(TestLogCollection))
+		logs := []*TestLog{
+			{Name: "test1", Task: "task1", TaskExecution: 0},
+			{Name: "", Task: "task1", TaskExecution: 0},
+		}
+		err := InsertManyTestLogs(logs)
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "invalid test log")
+
+		num, err := db.Count(TestLogCollection, bson.M{})
+		require.NoError(t, err)
+		assert.Equal(t, 0, num)
+
+		for _, log := range logs {
+			assert.Empty(t, log.Id, "logs should not be mutated when validation fails")
+		}
+	})
+}
+
+func TestTestLogURL(t *testing.T) {
+	log := &TestLog{
+		Name:          "suite/case name",
+		Task:          "task1",
+		TaskExecution: 0,
+	}
+	assert.Equal(t, "/test_log/task1/0/suite%2Fcase%20name", log.URL())
+}
+
+func TestFindTestLogsByTask(t *testing.T) {
+	require.NoError(t, db.Clear(TestLogCollection), "error clearing test log collection")
+
+	logs := []*TestLog{
+		{Name: "z-test", Task: "task1", TaskExecution: 0, Lines: []string{"a"}},
+		{Name: "a-test", Task: "task1", TaskExecution: 0, Lines: []string{"b"}},
+		{Name: "other-test", Task: "task1", TaskExecution: 1, Lines: []string{"c"}},
+		{Name: "different-task", Task: "task2", TaskExecution: 0, Lines: []string{"d"}},
+	}
+	for _, log := range logs {
+		require.NoError(t, log.Insert())
+	}
+
+	found, err := FindTestLogsByTask("task1", 0)
+	require.NoError(t, err)
+	require.Len(t, found, 2)
+	assert.Equal(t, "a-test", found[0].Name)
+	assert.Equal(t, "z-test", found[1].Name)
+
+	found, err = FindTestLogsByTask("nonexistent", 0)
+	require.NoError(t, err)
+	assert.Empty(t, found)
+	assert.NotNil(t, found)
+}
+
 func TestDeleteTestLogsWithLimit(t *testing.T) {
 	env := evergreen.GetEnvironment()
 	ctx, cancel := env.Context()
@@ -68,8 +172,10 @@ func TestDeleteTestLogsWithLimit(t *testing.T) {
 	})
 	t.Run("QueryValidation", func(t *testing.T) {
 		require.NoError(t, db.Clear(TestLogCollection))
-		require.NoError(t, db.Insert(TestLogCollection, bson.M{"_id": primitive.NewObjectIDFromTimestamp(time.Now().Add(time.Hour)).Hex()}))
-		require.NoError(t, db.Insert(TestLogCollection, bson.M{"_id": primitive.NewObjectIDFromTimestamp(time.Now().Add(-time.Hour)).Hex()}))
+		newID := primitive.NewObjectIDFromTimestamp(time.Now().Add(time.Hour)).Hex()
+		oldID := primitive.NewObjectIDFromTimestamp(time.Now().Add(-time.Hour)).Hex()
+		require.NoError(t, db.Insert(TestLogCollection, bson.M{"_id": newID}))
+		require.NoError(t, db.Insert(TestLogCollection, bson.M{"_id": oldID}))
 
 		num, err := db.Count(TestLogCollection, bson.M{})
 		require.NoError(t, err)
@@ -82,6 +188,10 @@ func TestDeleteTestLogsWithLimit(t *testing.T) {
 		num, err = db.Count(TestLogCollection, bson.M{})
 		require.NoError(t, err)
 		assert.Equal(t, 1, num)
+
+		remaining := &TestLog{}
+		require.NoError(t, db.FindOne(TestLogCollection, bson.M{}, db.NoProjection, db.NoSort, remaining))
+		assert.Equal(t, newID, remaining.Id)
 	})
 	t.Run("Parallel", func(t *testing.T) {
 		require.NoError(t, db.Clear(TestLogCollection))
@@ -2,6 +2,7 @@ package model
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -10,6 +11,7 @@ import (
 	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
+	"github.com/evergreen-ci/utility"
 	"github.com/mongodb/anser/bsonutil"
 	"github.com/pkg/errors"
 	"go.mongodb.org/mongo-driver/bson"
@@ -98,14 +100,81 @@ func (self *Version) Insert() error {
 	return db.Insert(VersionCollection, self)
 }
 
+// AddSatisfiedTrigger records that the version has satisfied the trigger
+// definition with the given ID. It's a no-op if the trigger is already
+// recorded, so it's safe to call more than once for the same definition.
 func (v *Version) AddSatisfiedTrigger(definitionID string) error {
 	if v.SatisfiedTriggers == nil {
 		v.SatisfiedTriggers = []string{}
 	}
+	if utility.StringSliceContains(v.SatisfiedTriggers, definitionID) {
+		return nil
+	}
 	v.SatisfiedTriggers = append(v.SatisfiedTriggers, definitionID)
 	return errors.Wrap(AddSatisfiedTrigger(v.Id, definitionID), "error adding satisfied trigger")
 }
 
+// RestartFailedTasks finds and restarts all failed tasks in the version. A
+// failed display task is restarted together with its execution tasks. It
+// returns the number of tasks restarted, not counting display tasks.
+func (v *Version) RestartFailedTasks(user string) (int, error) {
+	failedTasks, err := task.Find(task.ByVersion(v.Id).WithFields(task.StatusKey, task.DisplayOnlyKey))
+	if err != nil {
+		return 0, errors.Wrapf(err, "can't get tasks for version '%s'", v.Id)
+	}
+
+	taskIds := []string{}
+	numRestarted := 0
+	for _, t := range failedTasks {
+		if t.Status == evergreen.TaskFailed {
+			taskIds = append(taskIds, t.Id)
+			if !t.DisplayOnly {
+				numRestarted++
+			}
+		}
+	}
+	if len(taskIds) == 0 {
+		return 0, nil
+	}
+
+	if err = RestartVersion(v.Id, taskIds, false, user); err != nil {
+		return 0, errors.Wrapf(err, "error restarting failed tasks for version '%s'", v.Id)
+	}
+
+	return numRestarted, nil
+}
+
+// SetPriority sets the priority on all tasks in the version. A negative
+// priority also deactivates the tasks, mirroring SetTaskPriority's behavior
+// for an individual task.
+func (v *Version) SetPriority(priority int64) error {
+	return errors.Wrapf(SetVersionPriority(v.Id, priority, evergreen.User), "error setting priority for version '%s'", v.Id)
+}
+
+// AllBuildsActivated returns true if every build variant in the version has
+// already been activated.
+func (v *Version) AllBuildsActivated() bool {
+	for _, bv := range v.BuildVariants {
+		if !bv.Activated {
+			return false
+		}
+	}
+	return true
+}
+
+// PendingActivationCount returns the number of build variants in the version
+// that are not yet activated but should be activated as of now, based on
+// their ActivateAt time.
+func (v *Version) PendingActivationCount(now time.Time) int {
+	count := 0
+	for _, bv := range v.BuildVariants {
+		if bv.ShouldActivate(now) {
+			count++
+		}
+	}
+	return count
+}
+
 // GetTimeSpent returns the total time_taken and makespan of a version for
 // each task that has finished running
 func (v *Version) GetTimeSpent() (time.Duration, time.Duration, error) {
@@ -136,7 +205,15 @@ func VersionExistsForCommitQueueItem(cq *commitqueue.CommitQueue, issue, patchTy
 	if err != nil {
 		return false, errors.Wrapf(err, "error finding version '%s'", versionID)
 	}
-	return v != nil, nil
+	if v == nil {
+		return false, nil
+	}
+	if patchType == commitqueue.CLIPatchType && v.Requester != evergreen.MergeTestRequester {
+		// The issue happens to collide with the ID of a version that wasn't
+		// created for this commit queue item, so treat it as not existing.
+		return false, nil
+	}
+	return true, nil
 }
 
 // VersionBuildStatus stores metadata relating to each build
@@ -179,6 +256,28 @@ type VersionMetadata struct {
 	GitTag              GitTag
 }
 
+// Validate enforces that the metadata specifies at most one version creation
+// source. A version can be created as the result of a regular commit (in
+// which case none of these fields are set), or as the result of a git tag
+// trigger, a periodic build, or a project trigger, but it cannot be the
+// result of more than one of these at the same time.
+func (m VersionMetadata) Validate() error {
+	var sources []string
+	if m.GitTag.Tag != "" {
+		sources = append(sources, "git tag trigger")
+	}
+	if m.PeriodicBuildID != "" {
+		sources = append(sources, "periodic build")
+	}
+	if m.SourceVersion != nil {
+		sources = append(sources, "project trigger")
+	}
+	if len(sources) > 1 {
+		return errors.Errorf("version metadata specifies multiple creation sources: %s", strings.Join(sources, ", "))
+	}
+	return nil
+}
+
 var (
 	VersionBuildStatusVariantKey        = bsonutil.MustHaveTag(VersionBuildStatus{}, "BuildVariant")
 	VersionBuildStatusActivatedKey      = bsonutil.MustHaveTag(VersionBuildStatus{}, "Activated")
@@ -59,6 +59,11 @@ func VersionByIds(ids []string) db.Q {
 	return db.Query(bson.M{VersionIdKey: bson.M{"$in": ids}})
 }
 
+// VersionByBuildId returns a db.Q object which will find the version that owns the given build id.
+func VersionByBuildId(buildId string) db.Q {
+	return db.Query(bson.M{VersionBuildIdsKey: buildId})
+}
+
 // All is a query for all versions.
 var VersionAll = db.Query(bson.D{})
 
@@ -280,6 +285,12 @@ func VersionFindOneId(id string) (*Version, error) {
 	return VersionFindOne(VersionById(id))
 }
 
+// VersionFindOneByBuildId returns the version that owns the given build id, or
+// nil if no such version is found.
+func VersionFindOneByBuildId(buildID string) (*Version, error) {
+	return VersionFindOne(VersionByBuildId(buildID))
+}
+
 func VersionFindByIds(ids []string) ([]Version, error) {
 	return VersionFind(db.Query(bson.M{
 		VersionIdKey: bson.M{
@@ -324,7 +335,7 @@ func AddGitTag(versionId string, tag GitTag) error {
 func AddSatisfiedTrigger(versionID, definitionID string) error {
 	return VersionUpdateOne(bson.M{VersionIdKey: versionID},
 		bson.M{
-			"$push": bson.M{
+			"$addToSet": bson.M{
 				VersionSatisfiedTriggersKey: definitionID,
 			},
 		})
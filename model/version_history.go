@@ -16,9 +16,12 @@ const (
 	StaleVersionCutoff = 100
 )
 
-// Given a project name and a list of build variants, return the latest version
-// on which all the given build variants succeeded. Gives up after 100 versions.
-func FindLastPassingVersionForBuildVariants(project *Project, buildVariantNames []string) (*Version, error) {
+// Given a project name and a list of build variants, return the latest
+// version on which the given build variants succeeded. If requireAll is
+// true, every listed build variant must have succeeded in the same version;
+// otherwise, any one of them succeeding is sufficient. Gives up after 100
+// versions.
+func FindLastPassingVersionForBuildVariants(project *Project, buildVariantNames []string, requireAll bool) (*Version, error) {
 	if len(buildVariantNames) == 0 {
 		return nil, errors.New("No build variants specified!")
 	}
@@ -63,9 +66,10 @@ func FindLastPassingVersionForBuildVariants(project *Project, buildVariantNames
 				},
 			},
 		},
-		// Find builds that succeeded on all of the requested build variants
+		// Find commits that succeeded on the requested build variants: all of
+		// them if requireAll, otherwise any one of them.
 		{
-			"$match": bson.M{"numSucceeded": len(buildVariantNames)},
+			"$match": bson.M{"numSucceeded": requiredNumSucceeded(requireAll, len(buildVariantNames))},
 		},
 		// Order by commit order number, descending
 		{
@@ -107,3 +111,13 @@ func FindLastPassingVersionForBuildVariants(project *Project, buildVariantNames
 	}
 	return v, nil
 }
+
+// requiredNumSucceeded builds the $match criteria for the number of
+// succeeded build variants a commit must have: exactly numVariants if
+// requireAll, otherwise at least one.
+func requiredNumSucceeded(requireAll bool, numVariants int) interface{} {
+	if requireAll {
+		return numVariants
+	}
+	return bson.M{"$gte": 1}
+}
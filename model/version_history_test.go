@@ -39,12 +39,21 @@ func TestFindLastPassingVersionForBuildVariants(t *testing.T) {
 		insertPatchBuild("3ap", project, bv1, evergreen.BuildSucceeded, 3)
 		insertPatchBuild("3bp", project, bv2, evergreen.BuildFailed, 3)
 
-		version, err := FindLastPassingVersionForBuildVariants(&projectObj, []string{bv1, bv2})
+		version, err := FindLastPassingVersionForBuildVariants(&projectObj, []string{bv1, bv2}, true)
 
 		So(err, ShouldBeNil)
 		So(version, ShouldNotBeNil)
 		So(version.Id, ShouldEqual, "2")
 		So(version.RevisionOrderNumber, ShouldEqual, 2)
+
+		Convey("and with requireAll false, returns the most recent version where any variant succeeded", func() {
+			version, err := FindLastPassingVersionForBuildVariants(&projectObj, []string{bv1, bv2}, false)
+
+			So(err, ShouldBeNil)
+			So(version, ShouldNotBeNil)
+			So(version.Id, ShouldEqual, "3")
+			So(version.RevisionOrderNumber, ShouldEqual, 3)
+		})
 	})
 }
 
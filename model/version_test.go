@@ -8,7 +8,9 @@ import (
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
+	"github.com/evergreen-ci/evergreen/model/build"
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/utility"
 	. "github.com/smartystreets/goconvey/convey"
 	"github.com/stretchr/testify/assert"
@@ -125,7 +127,7 @@ func TestFindLastPeriodicBuild(t *testing.T) {
 
 func TestVersionExistsForCommitQueueIssue(t *testing.T) {
 	assert.NoError(t, db.Clear(VersionCollection))
-	v1 := Version{Id: "version-1234"}
+	v1 := Version{Id: "version-1234", Requester: evergreen.MergeTestRequester}
 	assert.NoError(t, v1.Insert())
 
 	for testName, testCase := range map[string]struct {
@@ -163,6 +165,168 @@ func TestVersionExistsForCommitQueueIssue(t *testing.T) {
 	}
 }
 
+func TestVersionExistsForCommitQueueItemCLIRequesterMismatch(t *testing.T) {
+	assert.NoError(t, db.Clear(VersionCollection))
+	v := Version{Id: "version-1234", Requester: evergreen.RepotrackerVersionRequester}
+	assert.NoError(t, v.Insert())
+
+	cq := &commitqueue.CommitQueue{
+		Queue: []commitqueue.CommitQueueItem{
+			{Issue: "version-1234"},
+		},
+	}
+
+	exists, err := VersionExistsForCommitQueueItem(cq, "version-1234", commitqueue.CLIPatchType)
+	assert.NoError(t, err)
+	assert.False(t, exists, "a version that collides with the issue ID but wasn't created by the merge should not count as existing")
+}
+
+func TestVersionRestartFailedTasks(t *testing.T) {
+	assert.NoError(t, db.ClearCollections(VersionCollection, build.Collection, task.Collection))
+
+	v := Version{Id: "v1"}
+	assert.NoError(t, v.Insert())
+
+	b := build.Build{
+		Id: "b1",
+		Tasks: []build.TaskCache{
+			{Id: "t1"},
+			{Id: "t2"},
+			{Id: "dt1"},
+		},
+	}
+	assert.NoError(t, b.Insert())
+
+	tasks := []task.Task{
+		{Id: "t1", Version: v.Id, BuildId: b.Id, Status: evergreen.TaskFailed},
+		{Id: "t2", Version: v.Id, BuildId: b.Id, Status: evergreen.TaskSucceeded},
+		{Id: "dt1", Version: v.Id, BuildId: b.Id, Status: evergreen.TaskFailed, DisplayOnly: true, ExecutionTasks: []string{"et1"}},
+		{Id: "et1", Version: v.Id, BuildId: b.Id, Status: evergreen.TaskFailed},
+	}
+	for _, dbTask := range tasks {
+		assert.NoError(t, dbTask.Insert())
+	}
+
+	numRestarted, err := v.RestartFailedTasks(evergreen.User)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, numRestarted)
+
+	dbTask1, err := task.FindOneId("t1")
+	assert.NoError(t, err)
+	assert.Equal(t, evergreen.TaskUndispatched, dbTask1.Status)
+
+	dbDt1, err := task.FindOneId("dt1")
+	assert.NoError(t, err)
+	assert.Equal(t, evergreen.TaskUndispatched, dbDt1.Status)
+
+	dbEt1, err := task.FindOneId("et1")
+	assert.NoError(t, err)
+	assert.Equal(t, evergreen.TaskUndispatched, dbEt1.Status, "execution task of a restarted display task should also be reset")
+}
+
+func TestVersionSetPriority(t *testing.T) {
+	assert.NoError(t, db.ClearCollections(VersionCollection, task.Collection))
+
+	v := Version{Id: "v1"}
+	assert.NoError(t, v.Insert())
+
+	t1 := task.Task{Id: "t1", Version: v.Id, Activated: true, Priority: 0}
+	t2 := task.Task{Id: "t2", Version: v.Id, Activated: true, Priority: 0}
+	assert.NoError(t, t1.Insert())
+	assert.NoError(t, t2.Insert())
+
+	assert.NoError(t, v.SetPriority(42))
+	dbTask1, err := task.FindOneId("t1")
+	assert.NoError(t, err)
+	assert.EqualValues(t, 42, dbTask1.Priority)
+	assert.True(t, dbTask1.Activated, "positive priority should not change activation")
+
+	assert.NoError(t, v.SetPriority(-1))
+	dbTask1, err = task.FindOneId("t1")
+	assert.NoError(t, err)
+	assert.EqualValues(t, -1, dbTask1.Priority)
+	assert.False(t, dbTask1.Activated, "negative priority should deactivate the task")
+
+	dbTask2, err := task.FindOneId("t2")
+	assert.NoError(t, err)
+	assert.EqualValues(t, -1, dbTask2.Priority)
+	assert.False(t, dbTask2.Activated)
+}
+
+func TestVersionFindOneByBuildId(t *testing.T) {
+	assert.NoError(t, db.Clear(VersionCollection))
+
+	v1 := Version{Id: "v1", BuildIds: []string{"b1", "b2"}}
+	assert.NoError(t, v1.Insert())
+	v2 := Version{Id: "v2", BuildIds: []string{"b3"}}
+	assert.NoError(t, v2.Insert())
+
+	found, err := VersionFindOneByBuildId("b2")
+	assert.NoError(t, err)
+	require.NotNil(t, found)
+	assert.Equal(t, v1.Id, found.Id)
+
+	found, err = VersionFindOneByBuildId("does-not-exist")
+	assert.NoError(t, err)
+	assert.Nil(t, found)
+}
+
+func TestVersionAddSatisfiedTriggerIsIdempotent(t *testing.T) {
+	assert.NoError(t, db.Clear(VersionCollection))
+
+	v := Version{Id: "v1"}
+	assert.NoError(t, v.Insert())
+
+	assert.NoError(t, v.AddSatisfiedTrigger("trigger1"))
+	assert.NoError(t, v.AddSatisfiedTrigger("trigger1"))
+	assert.Equal(t, []string{"trigger1"}, v.SatisfiedTriggers)
+
+	dbVersion, err := VersionFindOneId(v.Id)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"trigger1"}, dbVersion.SatisfiedTriggers)
+}
+
+func TestVersionMetadataValidate(t *testing.T) {
+	assert.NoError(t, VersionMetadata{}.Validate(), "a regular commit-triggered version sets none of the creation source fields")
+	assert.NoError(t, VersionMetadata{GitTag: GitTag{Tag: "v1.0.0"}}.Validate())
+	assert.NoError(t, VersionMetadata{PeriodicBuildID: "periodic1"}.Validate())
+	assert.NoError(t, VersionMetadata{SourceVersion: &Version{Id: "v1"}}.Validate())
+
+	err := VersionMetadata{GitTag: GitTag{Tag: "v1.0.0"}, PeriodicBuildID: "periodic1"}.Validate()
+	assert.Error(t, err)
+
+	err = VersionMetadata{GitTag: GitTag{Tag: "v1.0.0"}, SourceVersion: &Version{Id: "v1"}}.Validate()
+	assert.Error(t, err)
+
+	err = VersionMetadata{PeriodicBuildID: "periodic1", SourceVersion: &Version{Id: "v1"}}.Validate()
+	assert.Error(t, err)
+}
+
+func TestVersionAllBuildsActivated(t *testing.T) {
+	v := Version{
+		BuildVariants: []VersionBuildStatus{
+			{BuildVariant: "bv1", ActivationStatus: ActivationStatus{Activated: true}},
+			{BuildVariant: "bv2", ActivationStatus: ActivationStatus{Activated: true}},
+		},
+	}
+	assert.True(t, v.AllBuildsActivated())
+
+	v.BuildVariants[1].Activated = false
+	assert.False(t, v.AllBuildsActivated())
+}
+
+func TestVersionPendingActivationCount(t *testing.T) {
+	now := time.Now()
+	v := Version{
+		BuildVariants: []VersionBuildStatus{
+			{BuildVariant: "bv1", ActivationStatus: ActivationStatus{Activated: false, ActivateAt: now.Add(-time.Minute)}},
+			{BuildVariant: "bv2", ActivationStatus: ActivationStatus{Activated: false, ActivateAt: now.Add(time.Hour)}},
+			{BuildVariant: "bv3", ActivationStatus: ActivationStatus{Activated: true, ActivateAt: now.Add(-time.Minute)}},
+		},
+	}
+	assert.Equal(t, 1, v.PendingActivationCount(now))
+}
+
 func TestBuildVariantsStatusUnmarshal(t *testing.T) {
 	str := `
 {
@@ -76,6 +76,14 @@ var (
 		return nil
 	}
 
+	requireVersionIDFlag = func(c *cli.Context) error {
+		version := c.String(versionIDFlagName)
+		if version == "" {
+			return errors.New("must specify a version id")
+		}
+		return nil
+	}
+
 	requireModuleFlag = func(c *cli.Context) error {
 		if c.String(moduleFlagName) == "" {
 			return errors.New("must specify a module")
@@ -43,6 +43,7 @@ func CommitQueue() cli.Command {
 			mergeCommand(),
 			setModuleCommand(),
 			enqueuePatch(),
+			requeuePatch(),
 			backport(),
 		},
 	}
@@ -292,6 +293,56 @@ func enqueuePatch() cli.Command {
 	}
 }
 
+func requeuePatch() cli.Command {
+	return cli.Command{
+		Name:  "requeue",
+		Usage: "re-enqueue a patch that previously failed on the commit queue",
+		Flags: addPatchIDFlag(cli.BoolFlag{
+			Name:  forceFlagName,
+			Usage: "force item to front of queue",
+		}),
+		Before: mergeBeforeFuncs(
+			requirePatchIDFlag,
+			setPlainLogger,
+		),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			patchID := c.String(patchIDFlagName)
+			force := c.Bool(forceFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			ac, _, err := conf.getLegacyClients()
+			if err != nil {
+				return errors.Wrap(err, "problem accessing legacy evergreen client")
+			}
+
+			existingPatch, err := ac.GetPatch(patchID)
+			if err != nil {
+				return errors.Wrapf(err, "can't get patch '%s'", patchID)
+			}
+			if canEnqueue, reason := existingPatch.CommitQueueEligibility(); !canEnqueue {
+				return errors.Errorf("patch '%s' is not eligible to be enqueued: %s", patchID, reason)
+			}
+
+			position, err := client.EnqueueItem(ctx, patchID, force)
+			if err != nil {
+				return errors.Wrap(err, "problem enqueueing patch")
+			}
+			grip.Infof("Queue position is %d.", position)
+
+			return nil
+		},
+	}
+}
+
 func backport() cli.Command {
 	return cli.Command{
 		Name:  "backport",
@@ -15,6 +15,7 @@ const (
 	pathFlagName              = "path"
 	projectFlagName           = "project"
 	patchIDFlagName           = "patch"
+	versionIDFlagName         = "version"
 	moduleFlagName            = "module"
 	yesFlagName               = "yes"
 	variantsFlagName          = "variants"
@@ -36,6 +37,7 @@ const (
 	messageFlagName           = "message"
 	forceFlagName             = "force"
 	activeFlagName            = "active"
+	failedOnlyFlagName        = "failed-only"
 	refFlagName               = "ref"
 	quietFlagName             = "quiet"
 	dirFlagName               = "dir"
@@ -170,6 +172,20 @@ func addPatchIDFlag(flags ...cli.Flag) []cli.Flag {
 	})
 }
 
+func addVersionIDFlag(flags ...cli.Flag) []cli.Flag {
+	return append(flags, cli.StringFlag{
+		Name:  joinFlagNames(versionIDFlagName, "id", "i"),
+		Usage: "specify the ID of a version",
+	})
+}
+
+func addFailedOnlyFlag(flags ...cli.Flag) []cli.Flag {
+	return append(flags, cli.BoolFlag{
+		Name:  failedOnlyFlagName,
+		Usage: "only restart tasks that failed",
+	})
+}
+
 func addModuleFlag(flags ...cli.Flag) []cli.Flag {
 	return append(flags, cli.StringFlag{
 		Name:  joinFlagNames(moduleFlagName, "m"),
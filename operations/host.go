@@ -19,6 +19,7 @@ func Host() cli.Command {
 			hostProvision(),
 			hostSetup(),
 			hostSSH(),
+			hostSSHConfig(),
 			hostRunCommand(),
 			hostRsync(),
 		},
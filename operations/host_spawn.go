@@ -14,6 +14,7 @@ import (
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model/host"
+	"github.com/evergreen-ci/evergreen/rest/client"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/utility"
 	"github.com/google/shlex"
@@ -547,6 +548,50 @@ func hostSSH() cli.Command {
 	}
 }
 
+func hostSSHConfig() cli.Command {
+	const sshConfigTemplate = `Host %s
+    HostName %s
+    User %s
+`
+
+	return cli.Command{
+		Name:   "ssh-config",
+		Usage:  "print a ~/.ssh/config entry for a spawn host",
+		Flags:  addHostFlag(),
+		Before: mergeBeforeFuncs(setPlainLogger, requireHostFlag),
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().Parent().String(confFlagName)
+			hostID := c.String(hostFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			h, err := client.GetSpawnHost(ctx, hostID)
+			if err != nil {
+				return errors.Wrap(err, "problem getting host")
+			}
+			user := restModel.FromStringPtr(h.User)
+			addr := restModel.FromStringPtr(h.HostURL)
+			if addr == "" {
+				addr = restModel.FromStringPtr(h.IP)
+			}
+			if user == "" || addr == "" {
+				return errors.New("unable to generate ssh config without user or address")
+			}
+
+			fmt.Printf(sshConfigTemplate, hostID, addr, user)
+			return nil
+		},
+	}
+}
+
 func hostAttach() cli.Command {
 	const (
 		volumeFlagName = "volume"
@@ -961,11 +1006,23 @@ func hostTerminate() cli.Command {
 	return cli.Command{
 		Name:   "terminate",
 		Usage:  "terminate active spawn hosts",
-		Flags:  addHostFlag(),
-		Before: mergeBeforeFuncs(setPlainLogger, requireHostFlag),
+		Flags:  addYesFlag(addHostFlag()...),
+		Before: setPlainLogger,
 		Action: func(c *cli.Context) error {
 			confPath := c.Parent().Parent().String(confFlagName)
-			hostID := c.String(hostFlagName)
+			skipConfirm := c.Bool(yesFlagName)
+
+			hostIDs := c.Args()
+			if id := c.String(hostFlagName); id != "" {
+				hostIDs = append(cli.Args{id}, hostIDs...)
+			}
+			if len(hostIDs) == 0 {
+				return errors.New("must specify at least one host id")
+			}
+			if len(hostIDs) > 1 && !skipConfirm &&
+				!confirm(fmt.Sprintf("Terminate %d hosts? (y/n):", len(hostIDs)), false) {
+				return errors.New("terminate aborted")
+			}
 
 			ctx, cancel := context.WithCancel(context.Background())
 			defer cancel()
@@ -977,28 +1034,36 @@ func hostTerminate() cli.Command {
 			client := conf.setupRestCommunicator(ctx)
 			defer client.Close()
 
-			h, err := client.GetSpawnHost(ctx, hostID)
-			if err != nil {
-				return errors.Wrap(err, "problem getting spawn host")
-			}
-			if h.NoExpiration {
-				msg := fmt.Sprintf("This host is non-expirable. Please type '%s' if you are sure you want to terminate", deleteConfirmation)
-				if !confirmWithMatchingString(msg, deleteConfirmation) {
-					return nil
+			catcher := grip.NewBasicCatcher()
+			for _, hostID := range hostIDs {
+				if err := terminateSpawnHost(ctx, client, hostID, deleteConfirmation); err != nil {
+					catcher.Wrapf(err, "problem terminating host '%s'", hostID)
+					continue
 				}
+				grip.Infof("Terminated host '%s'", hostID)
 			}
-			err = client.TerminateSpawnHost(ctx, hostID)
-			if err != nil {
-				return errors.Wrap(err, "problem terminating host")
-			}
-
-			grip.Infof("Terminated host '%s'", hostID)
 
-			return nil
+			return catcher.Resolve()
 		},
 	}
 }
 
+// terminateSpawnHost terminates a single spawn host, prompting for explicit
+// confirmation first if the host is non-expirable.
+func terminateSpawnHost(ctx context.Context, comm client.Communicator, hostID string, deleteConfirmation string) error {
+	h, err := comm.GetSpawnHost(ctx, hostID)
+	if err != nil {
+		return errors.Wrap(err, "problem getting spawn host")
+	}
+	if h.NoExpiration {
+		msg := fmt.Sprintf("Host '%s' is non-expirable. Please type '%s' if you are sure you want to terminate", hostID, deleteConfirmation)
+		if !confirmWithMatchingString(msg, deleteConfirmation) {
+			return errors.New("termination not confirmed")
+		}
+	}
+	return errors.Wrap(comm.TerminateSpawnHost(ctx, hostID), "problem terminating host")
+}
+
 func hostRunCommand() cli.Command {
 	const (
 		scriptFlagName        = "script"
@@ -1167,6 +1232,7 @@ func hostRsync() cli.Command {
 		remoteIsLocalFlagName         = "remote-is-local"
 		makeParentDirectoriesFlagName = "make-parent-dirs"
 		excludeFlagName               = "exclude"
+		includeFlagName               = "include"
 		deleteFlagName                = "delete"
 		pullFlagName                  = "pull"
 		timeoutFlagName               = "timeout"
@@ -1241,6 +1307,13 @@ Examples:
 	This will mirror all the contents of the local dir1 in the remote dir2
 	except for dir1/excluded_dir and dir1/excluded_file.
 
+* Sync only a subset of files/directories, excluding everything else:
+
+	evergreen host rsync -l /path/to/local/dir1/ -r /path/to/remote/dir2/ --host <host_id> -c included_dir/*** -x '*'
+
+	NOTE: paths to included files/directories are relative to the source directory.
+	This will mirror only dir1/included_dir in the remote dir2 and nothing else.
+
 * Disable sanity checking prompt when mirroring directories:
 
 	evergreen host rsync -l /path/to/local/dir1/ -r /path/to/remote/dir2/ --host <host_id> --sanity-checks=false
@@ -1266,6 +1339,10 @@ Examples:
 				Name:  joinFlagNames(excludeFlagName, "x"),
 				Usage: "ignore syncing any files matched by the given pattern",
 			},
+			cli.StringSliceFlag{
+				Name:  joinFlagNames(includeFlagName, "c"),
+				Usage: "sync files matched by the given pattern, even if they would otherwise be excluded",
+			},
 			cli.BoolTFlag{
 				Name:  joinFlagNames(deleteFlagName, "d"),
 				Usage: "delete any files in the destination directory that are not present on the source directory (default: true)",
@@ -1299,6 +1376,14 @@ Examples:
 			mutuallyExclusiveArgs(true, hostFlagName, remoteIsLocalFlagName),
 			requireStringFlag(localPathFlagName),
 			requireStringFlag(remotePathFlagName),
+			func(c *cli.Context) error {
+				for _, pattern := range append(c.StringSlice(excludeFlagName), c.StringSlice(includeFlagName)...) {
+					if pattern == "" {
+						return errors.New("exclude/include patterns cannot be empty")
+					}
+				}
+				return nil
+			},
 		),
 		Action: func(c *cli.Context) error {
 			doSanityCheck := c.BoolT(sanityChecksFlagName)
@@ -1352,6 +1437,7 @@ Examples:
 				host:                 host,
 				makeRemoteParentDirs: makeParentDirsOnRemote,
 				excludePatterns:      c.StringSlice(excludeFlagName),
+				includePatterns:      c.StringSlice(includeFlagName),
 				shouldDelete:         c.BoolT(deleteFlagName),
 				pull:                 pull,
 				dryRun:               dryRun,
@@ -1465,6 +1551,7 @@ type rsyncOpts struct {
 	host                 string
 	makeRemoteParentDirs bool
 	excludePatterns      []string
+	includePatterns      []string
 	shouldDelete         bool
 	pull                 bool
 	dryRun               bool
@@ -1482,6 +1569,12 @@ func buildRsyncCommand(opts rsyncOpts) (*jasper.Command, error) {
 	if opts.shouldDelete {
 		args = append(args, "--delete")
 	}
+	// Include patterns must precede exclude patterns so that rsync's
+	// first-match-wins filter rules let includes carve exceptions out of the
+	// excludes.
+	for _, pattern := range opts.includePatterns {
+		args = append(args, "--include", pattern)
+	}
 	for _, pattern := range opts.excludePatterns {
 		args = append(args, "--exclude", pattern)
 	}
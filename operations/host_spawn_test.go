@@ -284,6 +284,33 @@ func TestHostRsync(t *testing.T) {
 			require.Len(t, exported, 1)
 			assert.Subset(t, exported[0].Args, params)
 		},
+		"IncludePatternsPrecedeExcludePatterns": func(ctx context.Context, t *testing.T, localFile, remoteFile, localDir, remoteDir string) {
+			cmd, err := buildRsyncCommand(rsyncOpts{
+				local:           makeCompatiblePath(ctx, t, localDir),
+				remote:          makeCompatiblePath(ctx, t, remoteDir),
+				includePatterns: []string{"included_dir/***"},
+				excludePatterns: []string{"*"},
+			})
+			require.NoError(t, err)
+			require.NotZero(t, cmd)
+			exported, err := cmd.Export()
+			require.NoError(t, err)
+			require.Len(t, exported, 1)
+			args := exported[0].Args
+			includeIndex := -1
+			excludeIndex := -1
+			for i, arg := range args {
+				if arg == "--include" {
+					includeIndex = i
+				}
+				if arg == "--exclude" {
+					excludeIndex = i
+				}
+			}
+			assert.NotEqual(t, -1, includeIndex)
+			assert.NotEqual(t, -1, excludeIndex)
+			assert.True(t, includeIndex < excludeIndex)
+		},
 	} {
 		t.Run(testName, func(t *testing.T) {
 			ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
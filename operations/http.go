@@ -2,6 +2,7 @@ package operations
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -13,8 +14,10 @@ import (
 
 	"github.com/evergreen-ci/gimlet"
 	"github.com/evergreen-ci/utility"
+	"github.com/jpillora/backoff"
 
 	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/apimodels"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/distro"
 	"github.com/evergreen-ci/evergreen/model/patch"
@@ -26,6 +29,10 @@ import (
 	"github.com/pkg/errors"
 )
 
+// defaultMaxDoReqAttempts is the default number of times doReq will attempt
+// a retryable request before giving up.
+const defaultMaxDoReqAttempts = 3
+
 // legacyClient manages requests to the API server endpoints, and unmarshaling the results into
 // usable structures.
 type legacyClient struct {
@@ -35,6 +42,11 @@ type legacyClient struct {
 	APIKey     string
 	APIRootV2  string
 	UIRoot     string
+
+	// maxRetries is the number of attempts made for retryable requests
+	// (idempotent GETs that fail with a connection error or 5xx status).
+	// It defaults to defaultMaxDoReqAttempts when unset.
+	maxRetries int
 }
 
 // APIError is an implementation of error for reporting unexpected results from API calls.
@@ -58,17 +70,77 @@ func NewAPIError(resp *http.Response) APIError {
 
 // doReq performs a request of the given method type against path.
 // If body is not nil, also includes it as a request body as url-encoded data with the
-// appropriate header
+// appropriate header. GET requests without a body are retried with exponential backoff
+// on connection errors and 5xx responses, up to ac.maxRetries attempts.
 func (ac *legacyClient) doReq(method, path string, apiVersion int, body io.Reader) (*http.Response, error) {
+	ctx := context.Background()
+
+	// Only idempotent GETs with an empty request body are retried; any other
+	// request is attempted once.
+	var bodyBytes []byte
+	if body != nil {
+		var err error
+		bodyBytes, err = ioutil.ReadAll(body)
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading request body")
+		}
+	}
+
+	maxAttempts := 1
+	if method == http.MethodGet && (bodyBytes == nil || len(bodyBytes) == 0) {
+		maxAttempts = ac.maxRetries
+		if maxAttempts <= 0 {
+			maxAttempts = defaultMaxDoReqAttempts
+		}
+	}
+
+	b := &backoff.Backoff{
+		Min:    100 * time.Millisecond,
+		Max:    2 * time.Second,
+		Factor: 2,
+		Jitter: true,
+	}
+
+	var resp *http.Response
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var reqBody io.Reader
+		if bodyBytes != nil {
+			reqBody = bytes.NewReader(bodyBytes)
+		}
+		resp, err = ac.doReqOnce(ctx, method, path, apiVersion, reqBody)
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			return resp, nil
+		}
+		if attempt == maxAttempts {
+			break
+		}
+		if resp != nil {
+			resp.Body.Close()
+		}
+		select {
+		case <-ctx.Done():
+			return nil, errors.Wrap(ctx.Err(), "request canceled")
+		case <-time.After(b.Duration()):
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// doReqOnce makes a single attempt at a request of the given method type against path.
+func (ac *legacyClient) doReqOnce(ctx context.Context, method, path string, apiVersion int, body io.Reader) (*http.Response, error) {
 	var req *http.Request
 	var err error
 
 	if apiVersion == 1 {
-		req, err = http.NewRequest(method, fmt.Sprintf("%s/%s", ac.APIRoot, path), body)
+		req, err = http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", ac.APIRoot, path), body)
 	} else if apiVersion == 2 {
-		req, err = http.NewRequest(method, fmt.Sprintf("%s/%s", ac.APIRootV2, path), body)
+		req, err = http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", ac.APIRootV2, path), body)
 	} else if apiVersion == -1 {
-		req, err = http.NewRequest(method, fmt.Sprintf("%s/%s", ac.UIRoot, path), body)
+		req, err = http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s/%s", ac.UIRoot, path), body)
 	} else {
 		return nil, errors.Errorf("invalid apiVersion")
 	}
@@ -112,6 +184,10 @@ func (ac *legacyClient) post2(path string, body io.Reader) (*http.Response, erro
 	return ac.doReq("POST", path, 2, body)
 }
 
+func (ac *legacyClient) patch2(path string, body io.Reader) (*http.Response, error) {
+	return ac.doReq(http.MethodPatch, path, 2, body)
+}
+
 func (ac *legacyClient) modifyExisting(patchId, action string) error {
 	data := struct {
 		PatchId string `json:"patch_id"`
@@ -141,21 +217,32 @@ func (ac *legacyClient) modifyExisting(patchId, action string) error {
 	return nil
 }
 
-// ValidateLocalConfig validates the local project config with the server
+// ValidateLocalConfig validates the local project config with the server,
+// including any warnings alongside errors.
 func (ac *legacyClient) ValidateLocalConfig(data []byte) (validator.ValidationErrors, error) {
-	resp, err := ac.post("validate", bytes.NewBuffer(data))
+	return ac.ValidateLocalConfigWithOptions(data, true)
+}
+
+// ValidateLocalConfigWithOptions validates the local project config with the
+// server. If includeWarnings is false, only errors are returned, so that
+// gating on the result does not fail on warnings alone.
+func (ac *legacyClient) ValidateLocalConfigWithOptions(data []byte, includeWarnings bool) (validator.ValidationErrors, error) {
+	resp, err := ac.post(fmt.Sprintf("validate?include_warnings=%v", includeWarnings), bytes.NewBuffer(data))
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode == http.StatusBadRequest {
-		errors := validator.ValidationErrors{}
-		err = utility.ReadJSON(resp.Body, &errors)
+		errs := validator.ValidationErrors{}
+		err = utility.ReadJSON(resp.Body, &errs)
 		if err != nil {
 			return nil, NewAPIError(resp)
 		}
-		return errors, nil
+		if !includeWarnings {
+			errs = errs.AtLevel(validator.Error)
+		}
+		return errs, nil
 	}
 	if resp.StatusCode == http.StatusUnauthorized {
 		return nil, client.AuthError
@@ -245,6 +332,114 @@ func (ac *legacyClient) GetPatch(patchId string) (*patch.Patch, error) {
 	return &res, nil
 }
 
+// GetVersion gets a version from the server given a version ID and returns
+// it as a Version.
+func (ac *legacyClient) GetVersion(versionID string) (*model.Version, error) {
+	resp, err := ac.get2(fmt.Sprintf("versions/%v", versionID), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return nil, client.AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, NewAPIError(resp)
+	}
+	apiModel := &restModel.APIVersion{}
+	if err = utility.ReadJSON(resp.Body, apiModel); err != nil {
+		return nil, err
+	}
+	i, err := apiModel.ToService()
+	if err != nil {
+		return nil, errors.Wrapf(err, "error building to version")
+	}
+	res, ok := i.(model.Version)
+	if !ok {
+		return nil, errors.Wrapf(err, "error converting type %T to Version", res)
+	}
+	return &res, nil
+}
+
+// VersionStatus is a lightweight summary of a version's status, for callers
+// that only need to check progress without handling the full Version.
+type VersionStatus struct {
+	Id     string
+	Status string
+}
+
+// GetVersionStatus fetches a lightweight summary of the given version's
+// status.
+func (ac *legacyClient) GetVersionStatus(versionID string) (*VersionStatus, error) {
+	v, err := ac.GetVersion(versionID)
+	if err != nil {
+		return nil, err
+	}
+	return &VersionStatus{
+		Id:     v.Id,
+		Status: v.Status,
+	}, nil
+}
+
+// AbortVersion aborts all tasks of the given version.
+func (ac *legacyClient) AbortVersion(versionID string) error {
+	resp, err := ac.post2(fmt.Sprintf("versions/%v/abort", versionID), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return client.AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return NewAPIError(resp)
+	}
+	return nil
+}
+
+// RestartVersion restarts the completed tasks of the given version. If
+// failedOnly is true, only tasks that failed are restarted.
+func (ac *legacyClient) RestartVersion(versionID string, failedOnly bool) error {
+	resp, err := ac.post2(fmt.Sprintf("versions/%v/restart?failed_only=%v", versionID, failedOnly), nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return client.AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return NewAPIError(resp)
+	}
+	return nil
+}
+
+// IsPatchFinalized returns whether the given patch has been finalized
+// (activated), without requiring the caller to parse the full patch.Patch.
+// It reuses the same GET plumbing as GetPatch.
+func (ac *legacyClient) IsPatchFinalized(patchId string) (bool, error) {
+	resp, err := ac.get2(fmt.Sprintf("patches/%v", patchId), nil)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return false, client.AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return false, NewAPIError(resp)
+	}
+	apiModel := &restModel.APIPatch{}
+	if err := utility.ReadJSON(resp.Body, apiModel); err != nil {
+		return false, err
+	}
+	return apiModel.Activated, nil
+}
+
 // GetProjectRef requests project details from the API server for a given project ID.
 func (ac *legacyClient) GetProjectRef(projectId string) (*model.ProjectRef, error) {
 	resp, err := ac.get(fmt.Sprintf("/ref/%s", projectId), nil)
@@ -309,8 +504,8 @@ func (ac *legacyClient) GetConfig(versionId string) ([]byte, error) {
 	if err != nil {
 		return nil, errors.Wrap(err, "error reading body")
 	}
-	return respBytes, nil
 
+	return respBytes, nil
 }
 
 // GetProject fetches the project details from the API server for a given project ID.
@@ -335,12 +530,18 @@ func (ac *legacyClient) GetProject(versionId string) (*model.Project, error) {
 	return model.GetProjectFromBSON(respBytes)
 }
 
-// GetLastGreen returns the most recent successful version for the given project and variants.
-func (ac *legacyClient) GetLastGreen(project string, variants []string) (*model.Version, error) {
+// GetLastGreen returns the most recent successful version for the given
+// project and variants. If requireAll is true, every listed variant must
+// have succeeded in the same version; otherwise, any one of them succeeding
+// is sufficient.
+func (ac *legacyClient) GetLastGreen(project string, variants []string, requireAll bool) (*model.Version, error) {
 	qs := []string{}
 	for _, v := range variants {
 		qs = append(qs, url.QueryEscape(v))
 	}
+	if !requireAll {
+		qs = append(qs, "require_all=false")
+	}
 	q := strings.Join(qs, "&")
 	resp, err := ac.get(fmt.Sprintf("projects/%v/last_green?%v", project, q), nil)
 	if err != nil {
@@ -388,6 +589,10 @@ type UpdatePatchModuleParams struct {
 
 // UpdatePatchModule makes a request to the API server to set a module patch on the given patch ID.
 func (ac *legacyClient) UpdatePatchModule(params UpdatePatchModuleParams) error {
+	if len(params.patch) > patch.SizeLimit {
+		return errors.Errorf("module patch is too large (%d > %d bytes)", len(params.patch), patch.SizeLimit)
+	}
+
 	// Characters in a string without a utf-8 representation are shoehorned into the � replacement character
 	// when marshalled into JSON.
 	// Because marshalling a byte slice to JSON will base64 encode it, the patch will be sent over the wire in base64
@@ -505,6 +710,10 @@ func (ac *legacyClient) ListDistros() ([]distro.Distro, error) {
 // PutPatch submits a new patch for the given project to the API server. If successful, returns
 // the patch object itself.
 func (ac *legacyClient) PutPatch(incomingPatch patchSubmission) (*patch.Patch, error) {
+	if len(incomingPatch.patchData) > patch.SizeLimit {
+		return nil, errors.Errorf("patch is too large (%d > %d bytes)", len(incomingPatch.patchData), patch.SizeLimit)
+	}
+
 	// Characters in a string without a utf-8 representation are shoehorned into the � replacement character
 	// when marshalled into JSON.
 	// Because marshalling a byte slice to JSON will base64 encode it, the patch will be sent over the wire in base64
@@ -598,6 +807,70 @@ func (ac *legacyClient) GetTask(taskId string) (*service.RestTask, error) {
 	return &reply, nil
 }
 
+// SetTaskPriority changes the priority of the given task. A negative
+// priority may be used to deprioritize or deactivate the task.
+func (ac *legacyClient) SetTaskPriority(taskID string, priority int64) error {
+	body, err := json.Marshal(struct {
+		Priority int64 `json:"priority"`
+	}{Priority: priority})
+	if err != nil {
+		return errors.Wrap(err, "error marshaling request body")
+	}
+
+	resp, err := ac.patch2(fmt.Sprintf("tasks/%v", taskID), bytes.NewBuffer(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusUnauthorized {
+		return client.AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		return NewAPIError(resp)
+	}
+	return nil
+}
+
+// taskLogTypes maps the logType values accepted by GetTaskLogs to the "type"
+// query parameter values expected by the task_log_raw UI route.
+var taskLogTypes = map[string]string{
+	"task":   apimodels.TaskLogPrefix,
+	"agent":  apimodels.AgentLogPrefix,
+	"system": apimodels.SystemLogPrefix,
+	"all":    service.AllLogsType,
+}
+
+// GetTaskLogs streams the logs of the given type ("task", "agent", "system",
+// or "all") for the given task. The caller is responsible for closing the
+// returned ReadCloser.
+func (ac *legacyClient) GetTaskLogs(taskID string, logType string) (io.ReadCloser, error) {
+	logTypeParam, ok := taskLogTypes[logType]
+	if !ok {
+		return nil, errors.Errorf("unrecognized log type '%s'", logType)
+	}
+
+	resp, err := ac.doReq(http.MethodGet, fmt.Sprintf("task_log_raw/%v/0?type=%v", taskID, logTypeParam), -1, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode == http.StatusNotFound {
+		resp.Body.Close()
+		return nil, errors.New("not found")
+	}
+	if resp.StatusCode == http.StatusUnauthorized {
+		resp.Body.Close()
+		return nil, client.AuthError
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		return nil, NewAPIError(resp)
+	}
+
+	return resp.Body, nil
+}
+
 // GetHostUtilizationStats takes in an integer granularity, which is in seconds, and the number of days back and makes a
 // REST API call to get host utilization statistics.
 func (ac *legacyClient) GetHostUtilizationStats(granularity, daysBack int, csv bool) (io.ReadCloser, error) {
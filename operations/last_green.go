@@ -44,7 +44,7 @@ func LastGreen() cli.Command {
 				return errors.Wrap(err, "problem accessing evergreen service")
 			}
 
-			v, err := rc.GetLastGreen(project, variants)
+			v, err := rc.GetLastGreen(project, variants, true)
 			if err != nil {
 				return err
 			}
@@ -195,19 +195,21 @@ func (s *ClientSettings) getLegacyClients() (*legacyClient, *legacyClient, error
 	}
 
 	ac := &legacyClient{
-		APIRoot:   s.APIServerHost,
-		APIRootV2: s.APIServerHost + "/rest/v2",
-		User:      s.User,
-		APIKey:    s.APIKey,
-		UIRoot:    s.UIServerHost,
+		APIRoot:    s.APIServerHost,
+		APIRootV2:  s.APIServerHost + "/rest/v2",
+		User:       s.User,
+		APIKey:     s.APIKey,
+		UIRoot:     s.UIServerHost,
+		maxRetries: defaultMaxDoReqAttempts,
 	}
 
 	rc := &legacyClient{
-		APIRoot:   apiURL.Scheme + "://" + apiURL.Host + "/rest/v1",
-		APIRootV2: apiURL.Scheme + "://" + apiURL.Host + "/rest/v2",
-		User:      s.User,
-		APIKey:    s.APIKey,
-		UIRoot:    s.UIServerHost,
+		APIRoot:    apiURL.Scheme + "://" + apiURL.Host + "/rest/v1",
+		APIRootV2:  apiURL.Scheme + "://" + apiURL.Host + "/rest/v2",
+		User:       s.User,
+		APIKey:     s.APIKey,
+		UIRoot:     s.UIServerHost,
+		maxRetries: defaultMaxDoReqAttempts,
 	}
 
 	return ac, rc, nil
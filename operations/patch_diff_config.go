@@ -0,0 +1,100 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/evergreen-ci/utility"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func PatchDiffConfig() cli.Command {
+	return cli.Command{
+		Name:   "patch-diff-config",
+		Usage:  "diff the variant/task selection between two patches",
+		Before: setPlainLogger,
+		Action: func(c *cli.Context) error {
+			if c.NArg() != 2 {
+				return errors.New("must specify exactly two patch IDs to diff")
+			}
+			confPath := c.Parent().String(confFlagName)
+			patchIdA := c.Args().Get(0)
+			patchIdB := c.Args().Get(1)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			_, rc, err := conf.getLegacyClients()
+			if err != nil {
+				return errors.Wrap(err, "problem accessing evergreen service")
+			}
+
+			patchA, err := rc.GetPatch(patchIdA)
+			if err != nil {
+				return errors.Wrapf(err, "problem fetching patch '%s'", patchIdA)
+			}
+			patchB, err := rc.GetPatch(patchIdB)
+			if err != nil {
+				return errors.Wrapf(err, "problem fetching patch '%s'", patchIdB)
+			}
+
+			printVariantTaskDiff(patchIdA, patchIdB, patchA.VariantsTasks, patchB.VariantsTasks)
+			return nil
+		},
+	}
+}
+
+// printVariantTaskDiff reports the build variants and tasks that are present
+// in one patch's resolved VariantTasks but not the other's.
+func printVariantTaskDiff(labelA, labelB string, vtsA, vtsB []patch.VariantTasks) {
+	bvsA, tasksA := patch.ResolveVariantTasks(vtsA)
+	bvsB, tasksB := patch.ResolveVariantTasks(vtsB)
+
+	anyDiff := printSetDiff("variants", labelA, labelB, diffStrings(bvsA, bvsB), diffStrings(bvsB, bvsA))
+	anyDiff = printSetDiff("tasks", labelA, labelB, diffStrings(tasksA, tasksB), diffStrings(tasksB, tasksA)) || anyDiff
+
+	if !anyDiff {
+		fmt.Println("No differences in variant/task selection.")
+	}
+}
+
+// printSetDiff prints the elements only in each side and returns whether
+// any differences were found.
+func printSetDiff(kind, labelA, labelB string, onlyInA, onlyInB []string) bool {
+	if len(onlyInA) > 0 {
+		fmt.Printf("%s only in %s:\n", kind, labelA)
+		for _, s := range onlyInA {
+			fmt.Printf("  %s\n", s)
+		}
+	}
+	if len(onlyInB) > 0 {
+		fmt.Printf("%s only in %s:\n", kind, labelB)
+		for _, s := range onlyInB {
+			fmt.Printf("  %s\n", s)
+		}
+	}
+	return len(onlyInA) > 0 || len(onlyInB) > 0
+}
+
+// diffStrings returns the sorted set of elements in a that are not in b.
+func diffStrings(a, b []string) []string {
+	var diff []string
+	for _, s := range a {
+		if !utility.StringSliceContains(b, s) {
+			diff = append(diff, s)
+		}
+	}
+	sort.Strings(diff)
+	return diff
+}
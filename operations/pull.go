@@ -50,7 +50,7 @@ func Pull() cli.Command {
 			client := conf.setupRestCommunicator(ctx)
 			defer client.Close()
 
-			creds, err := client.GetTaskSyncReadCredentials(ctx)
+			creds, err := client.GetTaskSyncReadCredentials(ctx, taskID)
 			if err != nil {
 				return errors.Wrap(err, "could not fetch credentials")
 			}
@@ -67,7 +67,7 @@ func Pull() cli.Command {
 			defer utility.PutHTTPClient(httpClient)
 			opts := pail.S3Options{
 				Name:        creds.Bucket,
-				Credentials: pail.CreateAWSCredentials(creds.Key, creds.Secret, ""),
+				Credentials: pail.CreateAWSCredentials(creds.Key, creds.Secret, creds.Token),
 				Region:      endpoints.UsEast1RegionID,
 				Permissions: pail.S3PermissionsBucketOwnerRead,
 				Verbose:     true,
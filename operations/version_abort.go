@@ -0,0 +1,45 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func VersionAbort() cli.Command {
+	return cli.Command{
+		Name:   "abort-version",
+		Usage:  "abort all tasks of an existing version",
+		Flags:  addVersionIDFlag(),
+		Before: requireVersionIDFlag,
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().String(confFlagName)
+			versionID := c.String(versionIDFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			ac, _, err := conf.getLegacyClients()
+			if err != nil {
+				return errors.Wrap(err, "problem accessing evergreen service")
+			}
+
+			if err = ac.AbortVersion(versionID); err != nil {
+				return err
+			}
+
+			fmt.Println("Version aborted.")
+			return nil
+		},
+	}
+}
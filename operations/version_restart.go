@@ -0,0 +1,46 @@
+package operations
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+)
+
+func VersionRestart() cli.Command {
+	return cli.Command{
+		Name:   "restart-version",
+		Usage:  "restart the completed tasks of an existing version",
+		Flags:  addFailedOnlyFlag(addVersionIDFlag()...),
+		Before: requireVersionIDFlag,
+		Action: func(c *cli.Context) error {
+			confPath := c.Parent().String(confFlagName)
+			versionID := c.String(versionIDFlagName)
+			failedOnly := c.Bool(failedOnlyFlagName)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			conf, err := NewClientSettings(confPath)
+			if err != nil {
+				return errors.Wrap(err, "problem loading configuration")
+			}
+
+			client := conf.setupRestCommunicator(ctx)
+			defer client.Close()
+
+			ac, _, err := conf.getLegacyClients()
+			if err != nil {
+				return errors.Wrap(err, "problem accessing evergreen service")
+			}
+
+			if err = ac.RestartVersion(versionID, failedOnly); err != nil {
+				return err
+			}
+
+			fmt.Println("Version restarted.")
+			return nil
+		},
+	}
+}
@@ -662,6 +662,9 @@ func CreateVersionFromConfig(ctx context.Context, projectInfo *model.ProjectInfo
 	if projectInfo.NotPopulated() {
 		return nil, errors.New("project ref and parser project cannot be nil")
 	}
+	if err := metadata.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid version metadata")
+	}
 
 	// create a version document
 	v, err := shellVersionFromRevision(ctx, projectInfo.Ref, metadata)
@@ -113,8 +113,9 @@ type Communicator interface {
 	GetRecentVersionsForProject(ctx context.Context, projectID, requester string) ([]restmodel.APIVersion, error)
 
 	// GetTaskSyncReadCredentials returns the credentials to fetch task
-	// directory from S3.
-	GetTaskSyncReadCredentials(ctx context.Context) (*evergreen.S3Credentials, error)
+	// directory from S3. If taskID is non-empty, the returned credentials
+	// are scoped to that task's sync prefix.
+	GetTaskSyncReadCredentials(ctx context.Context, taskID string) (*evergreen.S3Credentials, error)
 	// GetTaskSyncPath returns the path to the task directory in S3.
 	GetTaskSyncPath(ctx context.Context, taskID string) (string, error)
 
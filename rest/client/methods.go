@@ -1421,10 +1421,14 @@ func (c *communicatorImpl) GetRecentVersionsForProject(ctx context.Context, proj
 	return getVersionsResp, nil
 }
 
-func (c *communicatorImpl) GetTaskSyncReadCredentials(ctx context.Context) (*evergreen.S3Credentials, error) {
+func (c *communicatorImpl) GetTaskSyncReadCredentials(ctx context.Context, taskID string) (*evergreen.S3Credentials, error) {
+	path := "/task/sync_read_credentials"
+	if taskID != "" {
+		path = fmt.Sprintf("/task/sync_read_credentials?task_id=%s&scoped=true", taskID)
+	}
 	info := requestInfo{
 		method: http.MethodGet,
-		path:   "/task/sync_read_credentials",
+		path:   path,
 	}
 
 	resp, err := c.request(ctx, info, nil)
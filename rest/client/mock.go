@@ -307,7 +307,7 @@ func (c *Mock) GetRecentVersionsForProject(context.Context, string, string) ([]r
 	return nil, nil
 }
 
-func (c *Mock) GetTaskSyncReadCredentials(context.Context) (*evergreen.S3Credentials, error) {
+func (c *Mock) GetTaskSyncReadCredentials(context.Context, string) (*evergreen.S3Credentials, error) {
 	return &evergreen.S3Credentials{}, nil
 }
 
@@ -2,14 +2,20 @@ package data
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"reflect"
 	"sync"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/event"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/model/user"
 	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/evergreen/units"
@@ -34,6 +40,83 @@ func (ac *DBAdminConnector) GetBanner() (string, string, error) {
 	return settings.Banner, string(settings.BannerTheme), nil
 }
 
+// taskSyncReadSessionDuration is the lifetime of the temporary credentials
+// minted for a single task's scoped read access to its sync prefix.
+const taskSyncReadSessionDuration = 15 * time.Minute
+
+// CreateTaskSyncReadCredentials returns credentials for reading the given
+// task's sync directory from S3. If a task sync read role is configured, it
+// assumes that role with a policy limiting access to the task's own sync
+// prefix and returns the resulting temporary credentials. Otherwise, it
+// falls back to the unscoped task sync read credentials.
+func (ac *DBAdminConnector) CreateTaskSyncReadCredentials(t *task.Task) (*evergreen.S3Credentials, error) {
+	settings, err := evergreen.GetConfig()
+	if err != nil {
+		return nil, errors.Wrap(err, "error retrieving settings from DB")
+	}
+	awsConfig := settings.Providers.AWS
+	if awsConfig.TaskSyncReadRoleARN == "" {
+		return &awsConfig.TaskSyncRead, nil
+	}
+
+	sess, err := session.NewSession(&aws.Config{
+		Credentials: credentials.NewStaticCredentials(awsConfig.TaskSyncRead.Key, awsConfig.TaskSyncRead.Secret, ""),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating AWS session")
+	}
+
+	policy, err := scopedTaskSyncReadPolicy(awsConfig.TaskSyncRead.Bucket, t.S3Path(t.BuildVariant, t.DisplayName))
+	if err != nil {
+		return nil, errors.Wrap(err, "error building scoped task sync read policy")
+	}
+
+	out, err := sts.New(sess).AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String(awsConfig.TaskSyncReadRoleARN),
+		RoleSessionName: aws.String(fmt.Sprintf("task-sync-read-%s", t.Id)),
+		Policy:          aws.String(policy),
+		DurationSeconds: aws.Int64(int64(taskSyncReadSessionDuration.Seconds())),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "error assuming task sync read role")
+	}
+
+	return &evergreen.S3Credentials{
+		Key:    aws.StringValue(out.Credentials.AccessKeyId),
+		Secret: aws.StringValue(out.Credentials.SecretAccessKey),
+		Token:  aws.StringValue(out.Credentials.SessionToken),
+		Bucket: awsConfig.TaskSyncRead.Bucket,
+	}, nil
+}
+
+// scopedTaskSyncReadPolicy returns an IAM policy document granting read-only
+// access to objects under prefix within bucket.
+func scopedTaskSyncReadPolicy(bucket, prefix string) (string, error) {
+	policy := map[string]interface{}{
+		"Version": "2012-10-17",
+		"Statement": []map[string]interface{}{
+			{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:GetObject"},
+				"Resource": fmt.Sprintf("arn:aws:s3:::%s/%s*", bucket, prefix),
+			},
+			{
+				"Effect":   "Allow",
+				"Action":   []string{"s3:ListBucket"},
+				"Resource": fmt.Sprintf("arn:aws:s3:::%s", bucket),
+				"Condition": map[string]interface{}{
+					"StringLike": map[string]interface{}{"s3:prefix": []string{prefix + "*"}},
+				},
+			},
+		},
+	}
+	out, err := json.Marshal(policy)
+	if err != nil {
+		return "", errors.Wrap(err, "error marshalling policy")
+	}
+	return string(out), nil
+}
+
 // SetEvergreenSettings sets the admin settings document in the DB and event logs it
 func (ac *DBAdminConnector) SetEvergreenSettings(changes *restModel.APIAdminSettings,
 	oldSettings *evergreen.Settings, u *user.DBUser, persist bool) (*evergreen.Settings, error) {
@@ -242,6 +325,14 @@ func (ac *DBAdminConnector) UnmapLDAPGroupToRole(group string) error {
 type MockAdminConnector struct {
 	mu           sync.RWMutex
 	MockSettings *evergreen.Settings
+	// ScopedTaskSyncReadCreds, if set, is returned by
+	// CreateTaskSyncReadCredentials instead of the unscoped credentials
+	// in MockSettings, simulating a configured task sync read role.
+	ScopedTaskSyncReadCreds *evergreen.S3Credentials
+	// FailOnCreateTaskSyncReadCredentials causes
+	// CreateTaskSyncReadCredentials to return an error, for testing
+	// handlers that must gracefully surface credential-minting failures.
+	FailOnCreateTaskSyncReadCredentials bool
 }
 
 // GetEvergreenSettings retrieves the admin settings document from the mock connector
@@ -255,6 +346,19 @@ func (ac *MockAdminConnector) GetBanner() (string, string, error) {
 	return ac.MockSettings.Banner, string(ac.MockSettings.BannerTheme), nil
 }
 
+// CreateTaskSyncReadCredentials provides a mock implementation of scoped
+// task sync read credential minting.
+func (ac *MockAdminConnector) CreateTaskSyncReadCredentials(t *task.Task) (*evergreen.S3Credentials, error) {
+	if ac.FailOnCreateTaskSyncReadCredentials {
+		return nil, errors.New("mock failed to create task sync read credentials")
+	}
+	if ac.ScopedTaskSyncReadCreds != nil {
+		return ac.ScopedTaskSyncReadCreds, nil
+	}
+	creds := ac.MockSettings.Providers.AWS.TaskSyncRead
+	return &creds, nil
+}
+
 // SetEvergreenSettings sets the admin settings document in the mock connector
 func (ac *MockAdminConnector) SetEvergreenSettings(changes *restModel.APIAdminSettings,
 	oldSettings *evergreen.Settings, u *user.DBUser, persist bool) (*evergreen.Settings, error) {
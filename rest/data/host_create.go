@@ -32,8 +32,10 @@ import (
 // DBCreateHostConnector supports `host.create` commands from the agent.
 type DBCreateHostConnector struct{}
 
-// ListHostsForTask lists running hosts scoped to the task or the task's build.
-func (dc *DBCreateHostConnector) ListHostsForTask(ctx context.Context, taskID string) ([]host.Host, error) {
+// ListHostsForTask lists hosts spawned by `host.create` scoped to the task or
+// the task's build. statusFilter restricts the results to hosts with that
+// status; if empty, only running hosts are returned.
+func (dc *DBCreateHostConnector) ListHostsForTask(ctx context.Context, taskID, statusFilter string) ([]host.Host, error) {
 	env := evergreen.GetEnvironment()
 	t, err := task.FindOneId(taskID)
 	if err != nil {
@@ -44,9 +46,9 @@ func (dc *DBCreateHostConnector) ListHostsForTask(ctx context.Context, taskID st
 	}
 
 	catcher := grip.NewBasicCatcher()
-	hostsSpawnedByTask, err := host.FindHostsSpawnedByTask(t.Id, t.Execution)
+	hostsSpawnedByTask, err := host.FindHostsSpawnedByTask(t.Id, t.Execution, statusFilter)
 	catcher.Add(err)
-	hostsSpawnedByBuild, err := host.FindHostsSpawnedByBuild(t.BuildId)
+	hostsSpawnedByBuild, err := host.FindHostsSpawnedByBuild(t.BuildId, statusFilter)
 	catcher.Add(err)
 	if catcher.HasErrors() {
 		return nil, gimlet.ErrorResponse{StatusCode: http.StatusInternalServerError, Message: catcher.String()}
@@ -488,10 +490,30 @@ func (db *DBCreateHostConnector) GetDockerStatus(ctx context.Context, containerI
 }
 
 // MockCreateHostConnector mocks `DBCreateHostConnector`.
-type MockCreateHostConnector struct{}
+type MockCreateHostConnector struct {
+	// MockDockerLogs is returned by GetDockerLogs instead of hitting the
+	// (mock) Docker client.
+	MockDockerLogs string
+	// FailOnDockerLogs causes GetDockerLogs to return an error, simulating
+	// a Docker client failure.
+	FailOnDockerLogs bool
+
+	// MockDockerStatus is returned by GetDockerStatus instead of hitting the
+	// (mock) Docker client.
+	MockDockerStatus *cloud.ContainerStatus
+	// FailOnDockerStatus causes GetDockerStatus to return an error,
+	// simulating a Docker client failure.
+	FailOnDockerStatus bool
+}
 
 func (dc *MockCreateHostConnector) GetDockerLogs(ctx context.Context, containerId string, parent *host.Host,
 	settings *evergreen.Settings, options types.ContainerLogsOptions) (io.Reader, error) {
+	if dc.FailOnDockerLogs {
+		return nil, errors.New("manufactured fail")
+	}
+	if dc.MockDockerLogs != "" {
+		return strings.NewReader(dc.MockDockerLogs), nil
+	}
 	c := cloud.GetMockClient()
 	logs, err := c.GetDockerLogs(ctx, containerId, parent, options)
 	if err != nil {
@@ -502,6 +524,12 @@ func (dc *MockCreateHostConnector) GetDockerLogs(ctx context.Context, containerI
 
 func (dc *MockCreateHostConnector) GetDockerStatus(ctx context.Context, containerId string, parent *host.Host,
 	_ *evergreen.Settings) (*cloud.ContainerStatus, error) {
+	if dc.FailOnDockerStatus {
+		return nil, errors.New("manufactured fail")
+	}
+	if dc.MockDockerStatus != nil {
+		return dc.MockDockerStatus, nil
+	}
 	c := cloud.GetMockClient()
 	status, err := c.GetDockerStatus(ctx, containerId, parent)
 	if err != nil {
@@ -510,8 +538,9 @@ func (dc *MockCreateHostConnector) GetDockerStatus(ctx context.Context, containe
 	return status, nil
 }
 
-// ListHostsForTask lists running hosts scoped to the task or the task's build.
-func (*MockCreateHostConnector) ListHostsForTask(ctx context.Context, taskID string) ([]host.Host, error) {
+// ListHostsForTask lists hosts spawned by `host.create` scoped to the task or
+// the task's build.
+func (*MockCreateHostConnector) ListHostsForTask(ctx context.Context, taskID, statusFilter string) ([]host.Host, error) {
 	return nil, errors.New("method not implemented")
 }
 
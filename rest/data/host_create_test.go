@@ -82,12 +82,17 @@ func TestListHostsForTask(t *testing.T) {
 	require.NoError((&build.Build{Id: "build_1"}).Insert())
 
 	c := DBCreateHostConnector{}
-	found, err := c.ListHostsForTask(context.Background(), "task_1")
+	found, err := c.ListHostsForTask(context.Background(), "task_1", "")
 	assert.NoError(err)
 	require.Len(found, 3)
 	assert.Equal("4.com", found[0].Host)
 	assert.Equal("1.com", found[1].Host)
 	assert.Equal("abcd:1234:459c:2d00:cfe4:843b:1d60:8e47", found[1].IP)
+
+	found, err = c.ListHostsForTask(context.Background(), "task_1", evergreen.HostDecommissioned)
+	assert.NoError(err)
+	require.Len(found, 1)
+	assert.Equal("5", found[0].Id)
 }
 
 func TestCreateHostsFromTask(t *testing.T) {
@@ -45,13 +45,15 @@ type Connector interface {
 
 	// FindTaskById is a method to find a specific task given its ID.
 	FindTaskById(string) (*task.Task, error)
-	FindTaskWithinTimePeriod(time.Time, time.Time, string, []string) ([]task.Task, error)
+	FindTaskWithinTimePeriod(startedAfter, finishedBefore time.Time, project string, statuses []string, startTaskId string, limit int) ([]task.Task, error)
 	FindOldTasksByIDWithDisplayTasks(string) ([]task.Task, error)
 	FindTasksByIds([]string) ([]task.Task, error)
 	SetTaskPriority(*task.Task, string, int64) error
 	SetTaskActivated(string, string, bool) error
 	ResetTask(string, string) error
-	AbortTask(string, string) error
+	// AbortTask aborts the task matching the given task ID. The reason, if
+	// set, is recorded on the task so the abort is auditable.
+	AbortTask(taskId, user, reason string) error
 	CheckTaskSecret(string, *http.Request) (int, error)
 
 	// FindTasksByBuildId is a method to find a set of tasks which all have the same
@@ -79,15 +81,20 @@ type Connector interface {
 	// in the model (removing old variables if overwrite is set).
 	// If successful, updates the given projectVars with the updated projectVars.
 	UpdateProjectVars(string, *restModel.APIProjectVars, bool) error
-	// CopyProjectVars copies the variables for the first project to the second
-	CopyProjectVars(string, string) error
+
+	// DiffProjectVars reports how incoming vars would change the vars currently stored for
+	// the given project, without persisting anything.
+	DiffProjectVars(string, *restModel.APIProjectVars) (*ProjectVarsDiff, error)
+	// CopyProjectVars copies the variables for the first project to the second. If the
+	// third argument is non-empty, only those variable keys are copied.
+	CopyProjectVars(string, string, []string) error
 
 	// Find the project matching the given ProjectId.
 	FindProjectById(string) (*model.ProjectRef, error)
 	// Create/Update a project the given projectRef
 	CreateProject(*model.ProjectRef, *user.DBUser) error
 	UpdateProject(*model.ProjectRef) error
-	GetProjectAliasResults(*model.Project, string, bool) ([]restModel.APIVariantTasks, error)
+	GetProjectAliasResults(*model.Project, string, bool, string) ([]restModel.APIVariantTasks, error)
 
 	// GetProjectFromFile finds the file for the projectRef and returns the translated project, using the given token
 	GetProjectFromFile(context.Context, model.ProjectRef, string, string) (*model.Project, *model.ParserProject, error)
@@ -100,8 +107,10 @@ type Connector interface {
 
 	// UpdateProjectRevision updates the given project's revision
 	UpdateProjectRevision(string, string) error
-	// FindProjects is a method to find projects as ordered by name
-	FindProjects(string, int, int) ([]model.ProjectRef, error)
+	// FindProjects is a method to find projects as ordered by name. The
+	// returned ProjectsPageInfo indicates whether more projects exist beyond
+	// the given limit and, if so, the key to request the next page.
+	FindProjects(string, int, int, bool) ([]model.ProjectRef, *ProjectsPageInfo, error)
 	GetProjectWithCommitQueueByOwnerRepoAndBranch(string, string, string) (*model.ProjectRef, error)
 	FindEnabledProjectRefsByOwnerAndRepo(string, string) ([]model.ProjectRef, error)
 	RemoveAdminFromProjects(string) error
@@ -109,6 +118,10 @@ type Connector interface {
 	// GetVersionsAndVariants returns recent versions for a project
 	GetVersionsAndVariants(int, int, *model.Project) (*restModel.VersionVariantData, error)
 	GetProjectEventLog(string, time.Time, int) ([]restModel.APIProjectEvent, error)
+
+	// GetProjectEventLogInWindow returns up to n project events that occurred between
+	// the given after and before timestamps.
+	GetProjectEventLogInWindow(string, time.Time, time.Time, int) ([]restModel.APIProjectEvent, error)
 	CreateVersionFromConfig(context.Context, *model.ProjectInfo, model.VersionMetadata, bool) (*model.Version, error)
 	GetVersionsInProject(string, string, int, int) ([]restModel.APIVersion, error)
 
@@ -232,8 +245,9 @@ type Connector interface {
 	// in the same repository, at the pull request's close time
 	AbortPatchesFromPullRequest(*github.PullRequestEvent) error
 
-	// RestartVersion restarts all completed tasks of a version given its ID and the caller.
-	RestartVersion(string, string) error
+	// RestartVersion restarts all completed tasks of a version given its ID and
+	// the caller. If failedOnly is true, only tasks that failed are restarted.
+	RestartVersion(versionId, caller string, failedOnly bool) error
 	// SetPatchPriority and SetPatchActivated change the status of the input patch
 	SetPatchPriority(string, int64, string) error
 	SetPatchActivated(context.Context, string, string, bool, *evergreen.Settings) error
@@ -241,6 +255,12 @@ type Connector interface {
 	// GetEvergreenSettings/SetEvergreenSettings retrieves/sets the system-wide settings document
 	GetEvergreenSettings() (*evergreen.Settings, error)
 	GetBanner() (string, string, error)
+	// CreateTaskSyncReadCredentials returns credentials for reading the
+	// given task's sync directory from S3. If a task sync read role is
+	// configured, the returned credentials are a temporary session
+	// scoped to that task's sync prefix; otherwise they fall back to the
+	// unscoped task sync read credentials.
+	CreateTaskSyncReadCredentials(t *task.Task) (*evergreen.S3Credentials, error)
 	SetEvergreenSettings(*restModel.APIAdminSettings, *evergreen.Settings, *user.DBUser, bool) (*evergreen.Settings, error)
 	// SetAdminBanner sets set the banner in the system-wide settings document
 	SetAdminBanner(string, *user.DBUser) error
@@ -315,8 +335,9 @@ type Connector interface {
 	// Notifications
 	GetNotificationsStats() (*restModel.APIEventStats, error)
 
-	// ListHostsForTask lists running hosts scoped to the task or the task's build.
-	ListHostsForTask(context.Context, string) ([]host.Host, error)
+	// ListHostsForTask lists hosts spawned by `host.create` scoped to the task
+	// or the task's build, optionally filtered by status.
+	ListHostsForTask(ctx context.Context, taskID, statusFilter string) ([]host.Host, error)
 	MakeIntentHost(string, string, string, apimodels.CreateHost) (*host.Host, error)
 	CreateHostsFromTask(*task.Task, user.DBUser, string) error
 
@@ -350,5 +371,9 @@ type Connector interface {
 	//GetProjectSettingsEvent returns the ProjectSettingsEvents of the given identifier and ProjectRef
 	GetProjectSettingsEvent(p *model.ProjectRef) (*model.ProjectSettingsEvent, error)
 
+	// GetProjectSettingsEventWithoutRepo behaves like GetProjectSettingsEvent but does not
+	// require p to have an owner/repo, for projects that aren't tied to a GitHub repo.
+	GetProjectSettingsEventWithoutRepo(p *model.ProjectRef) (*model.ProjectSettingsEvent, error)
+
 	CompareTasks([]string) ([]string, map[string]map[string]string, error)
 }
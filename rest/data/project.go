@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -151,14 +152,30 @@ func (pc *DBProjectConnector) UpdateProjectRevision(projectID, revision string)
 	return nil
 }
 
-// FindProjects queries the backing database for the specified projects
-func (pc *DBProjectConnector) FindProjects(key string, limit int, sortDir int) ([]model.ProjectRef, error) {
-	projects, err := model.FindProjectRefs(key, limit, sortDir)
+// ProjectsPageInfo describes the pagination state of a FindProjects result:
+// whether more projects exist beyond the requested limit, and, if so, the
+// key to pass as the start_at of the next page.
+type ProjectsPageInfo struct {
+	HasMore bool
+	NextKey string
+}
+
+// FindProjects queries the backing database for the specified projects. If onlyEnabled is
+// true, disabled projects are excluded from the results.
+func (pc *DBProjectConnector) FindProjects(key string, limit int, sortDir int, onlyEnabled bool) ([]model.ProjectRef, *ProjectsPageInfo, error) {
+	projects, err := model.FindProjectRefs(key, limit+1, sortDir, onlyEnabled)
 	if err != nil {
-		return nil, errors.Wrapf(err, "problem fetching projects starting at project '%s'", key)
+		return nil, nil, errors.Wrapf(err, "problem fetching projects starting at project '%s'", key)
+	}
+
+	pageInfo := &ProjectsPageInfo{}
+	if len(projects) > limit {
+		pageInfo.HasMore = true
+		pageInfo.NextKey = projects[limit].Id
+		projects = projects[:limit]
 	}
 
-	return projects, nil
+	return projects, pageInfo, nil
 }
 
 // FindProjectVarsById returns the variables associated with the given project.
@@ -174,7 +191,7 @@ func (pc *DBProjectConnector) FindProjectVarsById(id string, redact bool) (*rest
 		}
 	}
 	if redact {
-		vars = vars.RedactPrivateVars()
+		vars = vars.RedactedCopy()
 	}
 
 	varsModel := restModel.APIProjectVars{}
@@ -196,6 +213,78 @@ func (pc *DBProjectConnector) RemoveAdminFromProjects(toDelete string) error {
 	return model.RemoveAdminFromProjects(toDelete)
 }
 
+// ProjectVarsDiff describes how an incoming set of project variables would change the
+// variables currently stored for a project.
+type ProjectVarsDiff struct {
+	Added     map[string]string `json:"added"`
+	Changed   map[string]string `json:"changed"`
+	Deleted   []string          `json:"deleted"`
+	Unchanged []string          `json:"unchanged"`
+}
+
+// DiffProjectVars compares incoming against the variables currently stored for projectID,
+// without persisting anything. A private var whose incoming value is the empty string
+// (the redacted placeholder returned to the UI) is reported as unchanged rather than as a
+// change to an empty string.
+func (pc *DBProjectConnector) DiffProjectVars(projectID string, incoming *restModel.APIProjectVars) (*ProjectVarsDiff, error) {
+	if incoming == nil {
+		return nil, errors.New("no incoming vars to diff")
+	}
+	existing, err := model.FindOneProjectVars(projectID)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error finding variables for project '%s'", projectID)
+	}
+	if existing == nil {
+		existing = &model.ProjectVars{}
+	}
+
+	diff := diffProjectVars(existing, incoming)
+	return diff, nil
+}
+
+func diffProjectVars(existing *model.ProjectVars, incoming *restModel.APIProjectVars) *ProjectVarsDiff {
+	diff := &ProjectVarsDiff{
+		Added:     map[string]string{},
+		Changed:   map[string]string{},
+		Deleted:   append([]string{}, incoming.VarsToDelete...),
+		Unchanged: []string{},
+	}
+	for key, incomingVal := range incoming.Vars {
+		existingVal, ok := existing.Vars[key]
+		if !ok {
+			diff.Added[key] = incomingVal
+			continue
+		}
+		if existing.PrivateVars[key] && incomingVal == "" {
+			diff.Unchanged = append(diff.Unchanged, key)
+			continue
+		}
+		if incomingVal == existingVal {
+			diff.Unchanged = append(diff.Unchanged, key)
+			continue
+		}
+		diff.Changed[key] = incomingVal
+	}
+
+	return diff
+}
+
+// errProjectVarsTooLarge builds the 400-style error returned when project vars fail
+// model.ValidateVarSizes, listing the offending keys (if any were individually oversized).
+func errProjectVarsTooLarge(oversizedKeys []string, totalTooLarge bool) error {
+	var msg string
+	switch {
+	case len(oversizedKeys) > 0:
+		msg = fmt.Sprintf("variables exceed max size of %d bytes: %s", model.MaxVarValueSize, strings.Join(oversizedKeys, ", "))
+	case totalTooLarge:
+		msg = fmt.Sprintf("total size of all variables exceeds max size of %d bytes", model.MaxVarsTotalSize)
+	}
+	return gimlet.ErrorResponse{
+		StatusCode: http.StatusBadRequest,
+		Message:    msg,
+	}
+}
+
 // UpdateProjectVars adds new variables, overwrites variables, and deletes variables for the given project.
 func (pc *DBProjectConnector) UpdateProjectVars(projectId string, varsModel *restModel.APIProjectVars, overwrite bool) error {
 	if varsModel == nil {
@@ -208,6 +297,10 @@ func (pc *DBProjectConnector) UpdateProjectVars(projectId string, varsModel *res
 	vars := v.(*model.ProjectVars)
 	vars.Id = projectId
 
+	if oversizedKeys, totalTooLarge := model.ValidateVarSizes(vars.Vars); len(oversizedKeys) > 0 || totalTooLarge {
+		return errProjectVarsTooLarge(oversizedKeys, totalTooLarge)
+	}
+
 	if overwrite {
 		if _, err = vars.Upsert(); err != nil {
 			return errors.Wrapf(err, "problem overwriting variables for project '%s'", vars.Id)
@@ -227,13 +320,43 @@ func (pc *DBProjectConnector) UpdateProjectVars(projectId string, varsModel *res
 	return nil
 }
 
-func (pc *DBProjectConnector) CopyProjectVars(oldProjectId, newProjectId string) error {
+// CopyProjectVars copies the variables for oldProjectId to newProjectId. If varsToCopy is
+// non-empty, only those keys (and their corresponding private/restricted flags) are copied;
+// otherwise all variables are copied.
+func (pc *DBProjectConnector) CopyProjectVars(oldProjectId, newProjectId string, varsToCopy []string) error {
 	vars, err := model.FindOneProjectVars(oldProjectId)
 	if err != nil {
 		return errors.Wrapf(err, "error finding variables for project '%s'", oldProjectId)
 	}
+	if vars == nil {
+		return errors.Errorf("variables for project '%s' not found", oldProjectId)
+	}
 	vars.Id = newProjectId
-	return errors.Wrapf(vars.Insert(), "error inserting variables for project '%s", newProjectId)
+	if len(varsToCopy) > 0 {
+		newVars := map[string]string{}
+		newPrivateVars := map[string]bool{}
+		newRestrictedVars := map[string]bool{}
+		for _, key := range varsToCopy {
+			if val, ok := vars.Vars[key]; ok {
+				newVars[key] = val
+			}
+			if vars.PrivateVars[key] {
+				newPrivateVars[key] = true
+			}
+			if vars.RestrictedVars[key] {
+				newRestrictedVars[key] = true
+			}
+		}
+		vars.Vars = newVars
+		vars.PrivateVars = newPrivateVars
+		vars.RestrictedVars = newRestrictedVars
+	}
+	// Use a per-key $set (like FindAndModify) rather than Insert so that
+	// copying vars into a project that already has a ProjectVars document
+	// merges into it instead of failing with a duplicate key error, leaving
+	// the destination's other vars intact.
+	_, err = vars.FindAndModify(nil)
+	return errors.Wrapf(err, "error upserting variables for project '%s'", newProjectId)
 }
 
 func (ac *DBProjectConnector) GetProjectEventLog(id string, before time.Time, n int) ([]restModel.APIProjectEvent, error) {
@@ -241,14 +364,29 @@ func (ac *DBProjectConnector) GetProjectEventLog(id string, before time.Time, n
 	if err != nil {
 		return nil, err
 	}
+
+	return buildAPIProjectEvents(events)
+}
+
+// GetProjectEventLogInWindow returns up to n project events for id that occurred in the
+// half-open window [after, before).
+func (ac *DBProjectConnector) GetProjectEventLogInWindow(id string, after, before time.Time, n int) ([]restModel.APIProjectEvent, error) {
+	events, err := model.ProjectEventsInWindow(id, after, before, n)
+	if err != nil {
+		return nil, err
+	}
+
+	return buildAPIProjectEvents(events)
+}
+
+func buildAPIProjectEvents(events model.ProjectChangeEvents) ([]restModel.APIProjectEvent, error) {
 	events.RedactPrivateVars()
 
 	out := []restModel.APIProjectEvent{}
 	catcher := grip.NewBasicCatcher()
 	for _, evt := range events {
 		apiEvent := restModel.APIProjectEvent{}
-		err = apiEvent.BuildFromService(evt)
-		if err != nil {
+		if err := apiEvent.BuildFromService(evt); err != nil {
 			catcher.Add(err)
 			continue
 		}
@@ -296,6 +434,16 @@ func (pc *DBProjectConnector) GetProjectSettingsEvent(p *model.ProjectRef) (*mod
 	if err != nil {
 		return nil, errors.Wrapf(err, "Database error finding github hook for project '%s'", p.Id)
 	}
+	return getProjectSettingsEvent(p, hook != nil)
+}
+
+// GetProjectSettingsEventWithoutRepo behaves like GetProjectSettingsEvent, but skips the
+// GitHub hook lookup so it can be used for projects that aren't tied to an owner/repo.
+func (pc *DBProjectConnector) GetProjectSettingsEventWithoutRepo(p *model.ProjectRef) (*model.ProjectSettingsEvent, error) {
+	return getProjectSettingsEvent(p, false)
+}
+
+func getProjectSettingsEvent(p *model.ProjectRef, githubHooksEnabled bool) (*model.ProjectSettingsEvent, error) {
 	projectVars, err := model.FindOneProjectVars(p.Id)
 	if err != nil {
 		return nil, errors.Wrapf(err, "error finding variables for project '%s'", p.Id)
@@ -313,7 +461,7 @@ func (pc *DBProjectConnector) GetProjectSettingsEvent(p *model.ProjectRef) (*mod
 	}
 	projectSettingsEvent := model.ProjectSettingsEvent{
 		ProjectRef:         *p,
-		GitHubHooksEnabled: hook != nil,
+		GitHubHooksEnabled: githubHooksEnabled,
 		Vars:               *projectVars,
 		Aliases:            projectAliases,
 		Subscriptions:      subscriptions,
@@ -321,8 +469,17 @@ func (pc *DBProjectConnector) GetProjectSettingsEvent(p *model.ProjectRef) (*mod
 	return &projectSettingsEvent, nil
 }
 
-func (pc *DBProjectConnector) GetProjectAliasResults(p *model.Project, alias string, includeDeps bool) ([]restModel.APIVariantTasks, error) {
-	projectAliases, err := model.FindAliasInProjectOrRepo(p.Identifier, alias)
+// GetProjectAliasResults resolves the variants/tasks that the given alias would select for p.
+// If alias is the git-tag alias and tag is non-empty, only the git-tag aliases whose GitTag
+// regex matches tag are resolved, mirroring how a real tag push is evaluated.
+func (pc *DBProjectConnector) GetProjectAliasResults(p *model.Project, alias string, includeDeps bool, tag string) ([]restModel.APIVariantTasks, error) {
+	var projectAliases []model.ProjectAlias
+	var err error
+	if alias == evergreen.GitTagAlias && tag != "" {
+		projectAliases, err = model.FindMatchingGitTagAliasesInProject(p.Identifier, tag)
+	} else {
+		projectAliases, err = model.FindAliasInProjectOrRepo(p.Identifier, alias)
+	}
 	if err != nil {
 		return nil, gimlet.ErrorResponse{
 			StatusCode: http.StatusNotFound,
@@ -364,14 +521,17 @@ type MockProjectConnector struct {
 
 // FindProjects queries the cached projects slice for the matching projects.
 // Assumes CachedProjects is sorted in alphabetical order of project identifier.
-func (pc *MockProjectConnector) FindProjects(key string, limit int, sortDir int) ([]model.ProjectRef, error) {
+func (pc *MockProjectConnector) FindProjects(key string, limit int, sortDir int, onlyEnabled bool) ([]model.ProjectRef, *ProjectsPageInfo, error) {
 	projects := []model.ProjectRef{}
 	if sortDir > 0 {
 		for i := 0; i < len(pc.CachedProjects); i++ {
 			p := pc.CachedProjects[i]
+			if onlyEnabled && !p.Enabled {
+				continue
+			}
 			if p.Id >= key {
 				projects = append(projects, p)
-				if len(projects) == limit {
+				if len(projects) == limit+1 {
 					break
 				}
 			}
@@ -379,15 +539,26 @@ func (pc *MockProjectConnector) FindProjects(key string, limit int, sortDir int)
 	} else {
 		for i := len(pc.CachedProjects) - 1; i >= 0; i-- {
 			p := pc.CachedProjects[i]
+			if onlyEnabled && !p.Enabled {
+				continue
+			}
 			if p.Id < key {
 				projects = append(projects, p)
-				if len(projects) == limit {
+				if len(projects) == limit+1 {
 					break
 				}
 			}
 		}
 	}
-	return projects, nil
+
+	pageInfo := &ProjectsPageInfo{}
+	if len(projects) > limit {
+		pageInfo.HasMore = true
+		pageInfo.NextKey = projects[limit].Id
+		projects = projects[:limit]
+	}
+
+	return projects, pageInfo, nil
 }
 
 func (pc *MockProjectConnector) FindProjectById(projectId string) (*model.ProjectRef, error) {
@@ -463,7 +634,7 @@ func (pc *MockProjectConnector) FindProjectVarsById(id string, redact bool) (*re
 			}
 			res.PrivateVars = v.PrivateVars
 			if redact {
-				res = res.RedactPrivateVars()
+				res = res.RedactedCopy()
 			}
 
 			if err := varsModel.BuildFromService(res); err != nil {
@@ -478,7 +649,26 @@ func (pc *MockProjectConnector) FindProjectVarsById(id string, redact bool) (*re
 	}
 }
 
+func (pc *MockProjectConnector) DiffProjectVars(projectID string, incoming *restModel.APIProjectVars) (*ProjectVarsDiff, error) {
+	if incoming == nil {
+		return nil, errors.New("no incoming vars to diff")
+	}
+	existing := &model.ProjectVars{}
+	for _, v := range pc.CachedVars {
+		if v.Id == projectID {
+			existing = v
+			break
+		}
+	}
+
+	return diffProjectVars(existing, incoming), nil
+}
+
 func (pc *MockProjectConnector) UpdateProjectVars(projectId string, varsModel *restModel.APIProjectVars, overwrite bool) error {
+	if oversizedKeys, totalTooLarge := model.ValidateVarSizes(varsModel.Vars); len(oversizedKeys) > 0 || totalTooLarge {
+		return errProjectVarsTooLarge(oversizedKeys, totalTooLarge)
+	}
+
 	tempVars := &model.ProjectVars{
 		Id:   projectId,
 		Vars: map[string]string{},
@@ -525,24 +715,98 @@ func (pc *MockProjectConnector) UpdateProjectVars(projectId string, varsModel *r
 	return nil
 }
 
-func (pc *MockProjectConnector) CopyProjectVars(oldProjectId, newProjectId string) error {
-	newVars := model.ProjectVars{Id: newProjectId}
+func (pc *MockProjectConnector) CopyProjectVars(oldProjectId, newProjectId string, varsToCopy []string) error {
+	var source *model.ProjectVars
 	for _, v := range pc.CachedVars {
 		if v.Id == oldProjectId {
-			newVars.Vars = v.Vars
-			newVars.PrivateVars = v.PrivateVars
-			newVars.RestrictedVars = v.RestrictedVars
-			pc.CachedVars = append(pc.CachedVars, &newVars)
-			return nil
+			source = v
+			break
+		}
+	}
+	if source == nil {
+		return errors.Errorf("error finding variables for project '%s'", oldProjectId)
+	}
+
+	toCopy := model.ProjectVars{}
+	if len(varsToCopy) == 0 {
+		toCopy.Vars = source.Vars
+		toCopy.PrivateVars = source.PrivateVars
+		toCopy.RestrictedVars = source.RestrictedVars
+	} else {
+		toCopy.Vars = map[string]string{}
+		toCopy.PrivateVars = map[string]bool{}
+		toCopy.RestrictedVars = map[string]bool{}
+		for _, key := range varsToCopy {
+			if val, ok := source.Vars[key]; ok {
+				toCopy.Vars[key] = val
+			}
+			if source.PrivateVars[key] {
+				toCopy.PrivateVars[key] = true
+			}
+			if source.RestrictedVars[key] {
+				toCopy.RestrictedVars[key] = true
+			}
 		}
 	}
-	return errors.Errorf("error finding variables for project '%s'", oldProjectId)
+
+	// Merge into the destination's existing vars, if any, rather than
+	// appending a new entry, so copying leaves the destination's other
+	// vars intact.
+	var dest *model.ProjectVars
+	for _, v := range pc.CachedVars {
+		if v.Id == newProjectId {
+			dest = v
+			break
+		}
+	}
+	if dest == nil {
+		dest = &model.ProjectVars{Id: newProjectId}
+		pc.CachedVars = append(pc.CachedVars, dest)
+	}
+	if dest.Vars == nil {
+		dest.Vars = map[string]string{}
+	}
+	if dest.PrivateVars == nil {
+		dest.PrivateVars = map[string]bool{}
+	}
+	if dest.RestrictedVars == nil {
+		dest.RestrictedVars = map[string]bool{}
+	}
+	for key, val := range toCopy.Vars {
+		dest.Vars[key] = val
+	}
+	for key, val := range toCopy.PrivateVars {
+		dest.PrivateVars[key] = val
+	}
+	for key, val := range toCopy.RestrictedVars {
+		dest.RestrictedVars[key] = val
+	}
+
+	return nil
 }
 
 func (pc *MockProjectConnector) GetProjectEventLog(id string, before time.Time, n int) ([]restModel.APIProjectEvent, error) {
 	return pc.CachedEvents, nil
 }
 
+// GetProjectEventLogInWindow slices CachedEvents down to those with a timestamp in
+// the half-open window [after, before), up to n events.
+func (pc *MockProjectConnector) GetProjectEventLogInWindow(id string, after, before time.Time, n int) ([]restModel.APIProjectEvent, error) {
+	out := []restModel.APIProjectEvent{}
+	for _, evt := range pc.CachedEvents {
+		if evt.Timestamp == nil {
+			continue
+		}
+		if !evt.Timestamp.Before(after) && evt.Timestamp.Before(before) {
+			out = append(out, evt)
+			if len(out) == n {
+				break
+			}
+		}
+	}
+	return out, nil
+}
+
 func (pc *MockProjectConnector) GetProjectWithCommitQueueByOwnerRepoAndBranch(owner, repo, branch string) (*model.ProjectRef, error) {
 	for _, p := range pc.CachedProjects {
 		if p.Owner == owner && p.Repo == repo && p.Branch == branch && p.CommitQueue.Enabled == true {
@@ -589,6 +853,12 @@ func (pc *MockProjectConnector) GetProjectSettingsEvent(p *model.ProjectRef) (*m
 	return &model.ProjectSettingsEvent{}, nil
 }
 
-func (pc *MockProjectConnector) GetProjectAliasResults(*model.Project, string, bool) ([]restModel.APIVariantTasks, error) {
+// GetProjectSettingsEventWithoutRepo behaves like GetProjectSettingsEvent, but doesn't
+// require p to have an owner/repo set.
+func (pc *MockProjectConnector) GetProjectSettingsEventWithoutRepo(p *model.ProjectRef) (*model.ProjectSettingsEvent, error) {
+	return &model.ProjectSettingsEvent{ProjectRef: *p}, nil
+}
+
+func (pc *MockProjectConnector) GetProjectAliasResults(*model.Project, string, bool, string) ([]restModel.APIVariantTasks, error) {
 	return nil, nil
 }
@@ -1,9 +1,12 @@
 package data
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/db"
 	"github.com/evergreen-ci/evergreen/model"
 	"github.com/evergreen-ci/evergreen/model/event"
@@ -207,6 +210,7 @@ func TestMockProjectConnectorGetSuite(t *testing.T) {
 				{
 					Id:          "projectA",
 					Private:     false,
+					Enabled:     true,
 					CommitQueue: model.CommitQueueParams{Enabled: true},
 					Owner:       "evergreen-ci",
 					Repo:        "gimlet",
@@ -223,6 +227,7 @@ func TestMockProjectConnectorGetSuite(t *testing.T) {
 				{
 					Id:          "projectC",
 					Private:     true,
+					Enabled:     true,
 					CommitQueue: model.CommitQueueParams{Enabled: true},
 					Owner:       "evergreen-ci",
 					Repo:        "evergreen",
@@ -257,62 +262,88 @@ func (s *ProjectConnectorGetSuite) TearDownSuite() {
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchTooManyAsc() {
-	projects, err := s.ctx.FindProjects("", 7, 1)
+	projects, pageInfo, err := s.ctx.FindProjects("", 7, 1, false)
 	s.NoError(err)
 	s.NotNil(projects)
 	s.Len(projects, 6)
+	s.False(pageInfo.HasMore)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchTooManyDesc() {
-	projects, err := s.ctx.FindProjects("zzz", 7, -1)
+	projects, pageInfo, err := s.ctx.FindProjects("zzz", 7, -1, false)
 	s.NoError(err)
 	s.NotNil(projects)
 	s.Len(projects, 6)
+	s.False(pageInfo.HasMore)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchExactNumber() {
-	projects, err := s.ctx.FindProjects("", 3, 1)
+	projects, pageInfo, err := s.ctx.FindProjects("", 3, 1, false)
 	s.NoError(err)
 	s.NotNil(projects)
 	s.Len(projects, 3)
+	s.True(pageInfo.HasMore)
+	s.Equal("projectD", pageInfo.NextKey)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchTooFewAsc() {
-	projects, err := s.ctx.FindProjects("", 2, 1)
+	projects, pageInfo, err := s.ctx.FindProjects("", 2, 1, false)
 	s.NoError(err)
 	s.NotNil(projects)
 	s.Len(projects, 2)
+	s.True(pageInfo.HasMore)
+	s.Equal("projectC", pageInfo.NextKey)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchTooFewDesc() {
-	projects, err := s.ctx.FindProjects("zzz", 2, -1)
+	projects, pageInfo, err := s.ctx.FindProjects("zzz", 2, -1, false)
 	s.NoError(err)
 	s.NotNil(projects)
 	s.Len(projects, 2)
+	s.True(pageInfo.HasMore)
+	s.Equal("projectD", pageInfo.NextKey)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchKeyWithinBoundAsc() {
-	projects, err := s.ctx.FindProjects("projectB", 1, 1)
+	projects, pageInfo, err := s.ctx.FindProjects("projectB", 1, 1, false)
 	s.NoError(err)
 	s.Len(projects, 1)
+	s.True(pageInfo.HasMore)
+	s.Equal("projectC", pageInfo.NextKey)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchKeyWithinBoundDesc() {
-	projects, err := s.ctx.FindProjects("projectD", 1, -1)
+	projects, pageInfo, err := s.ctx.FindProjects("projectD", 1, -1, false)
 	s.NoError(err)
 	s.Len(projects, 1)
+	s.True(pageInfo.HasMore)
+	s.Equal("projectB", pageInfo.NextKey)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchKeyOutOfBoundAsc() {
-	projects, err := s.ctx.FindProjects("zzz", 1, 1)
+	projects, pageInfo, err := s.ctx.FindProjects("zzz", 1, 1, false)
 	s.NoError(err)
 	s.Len(projects, 0)
+	s.False(pageInfo.HasMore)
 }
 
 func (s *ProjectConnectorGetSuite) TestFetchKeyOutOfBoundDesc() {
-	projects, err := s.ctx.FindProjects("aaa", 1, -1)
+	projects, pageInfo, err := s.ctx.FindProjects("aaa", 1, -1, false)
 	s.NoError(err)
 	s.Len(projects, 0)
+	s.False(pageInfo.HasMore)
+}
+
+func (s *ProjectConnectorGetSuite) TestFetchOnlyEnabled() {
+	projects, pageInfo, err := s.ctx.FindProjects("", 7, 1, true)
+	s.NoError(err)
+	s.Len(projects, 2)
+	s.False(pageInfo.HasMore)
+	for _, p := range projects {
+		s.True(p.Enabled, "FindProjects with onlyEnabled=true should not return disabled project '%s'", p.Id)
+	}
+	s.Equal("projectA", projects[0].Id)
+	s.Equal("projectC", projects[1].Id)
 }
 
 func (s *ProjectConnectorGetSuite) TestGetProjectEvents() {
@@ -321,6 +352,27 @@ func (s *ProjectConnectorGetSuite) TestGetProjectEvents() {
 	s.Equal(projEventCount, len(events))
 }
 
+func (s *ProjectConnectorGetSuite) TestGetProjectEventsInWindow() {
+	events, err := s.ctx.GetProjectEventLogInWindow(projectId, time.Now().Add(-time.Hour), time.Now().Add(time.Hour), 0)
+	s.NoError(err)
+	s.Equal(projEventCount, len(events))
+
+	events, err = s.ctx.GetProjectEventLogInWindow(projectId, time.Now().Add(time.Hour), time.Now().Add(2*time.Hour), 0)
+	s.NoError(err)
+	s.Empty(events)
+}
+
+func (s *ProjectConnectorGetSuite) TestGetProjectEventsInWindowIncludesAfterBoundary() {
+	allEvents, err := s.ctx.GetProjectEventLogInWindow(projectId, time.Time{}, time.Now().Add(time.Hour), 0)
+	s.NoError(err)
+	s.Require().Len(allEvents, projEventCount)
+	oldest := *allEvents[len(allEvents)-1].Timestamp
+
+	events, err := s.ctx.GetProjectEventLogInWindow(projectId, oldest, time.Now().Add(time.Hour), 0)
+	s.NoError(err)
+	s.Equal(projEventCount, len(events), "window lower bound should be inclusive")
+}
+
 func (s *ProjectConnectorGetSuite) TestGetProjectWithCommitQueueByOwnerRepoAndBranch() {
 	projRef, err := s.ctx.GetProjectWithCommitQueueByOwnerRepoAndBranch("octocat", "hello-world", "master")
 	s.NoError(err)
@@ -358,6 +410,20 @@ func (s *ProjectConnectorGetSuite) TestGetProjectSettingsEventNoRepo() {
 	s.Nil(projectSettingsEvent)
 }
 
+func (s *ProjectConnectorGetSuite) TestGetProjectSettingsEventWithoutRepo() {
+	projRef := &model.ProjectRef{
+		Owner:   "admin",
+		Enabled: true,
+		Private: true,
+		Id:      projectId,
+		Admins:  []string{},
+	}
+	projectSettingsEvent, err := s.ctx.GetProjectSettingsEventWithoutRepo(projRef)
+	s.NoError(err)
+	s.Require().NotNil(projectSettingsEvent)
+	s.False(projectSettingsEvent.GitHubHooksEnabled)
+}
+
 func (s *ProjectConnectorGetSuite) TestFindProjectVarsById() {
 	// redact private variables
 	res, err := s.ctx.FindProjectVarsById(projectId, true)
@@ -398,8 +464,29 @@ func (s *ProjectConnectorGetSuite) TestUpdateProjectVars() {
 	s.NoError(s.ctx.UpdateProjectVars("not-an-id", &newVars, false))
 }
 
+func (s *ProjectConnectorGetSuite) TestUpdateProjectVarsRejectsOversizedValue() {
+	oversizedVars := restModel.APIProjectVars{
+		Vars: map[string]string{"big": strings.Repeat("a", model.MaxVarValueSize+1)},
+	}
+	err := s.ctx.UpdateProjectVars(projectId, &oversizedVars, false)
+	s.Error(err)
+	s.Contains(err.Error(), "big")
+}
+
+func (s *ProjectConnectorGetSuite) TestUpdateProjectVarsRejectsOversizedTotal() {
+	oversizedVars := restModel.APIProjectVars{
+		Vars: map[string]string{},
+	}
+	for i := 0; i < 100; i++ {
+		oversizedVars.Vars[fmt.Sprintf("key%d", i)] = strings.Repeat("a", model.MaxVarValueSize)
+	}
+	err := s.ctx.UpdateProjectVars(projectId, &oversizedVars, false)
+	s.Error(err)
+	s.Contains(err.Error(), "total size")
+}
+
 func (s *ProjectConnectorGetSuite) TestCopyProjectVars() {
-	s.NoError(s.ctx.CopyProjectVars(projectId, "project-copy"))
+	s.NoError(s.ctx.CopyProjectVars(projectId, "project-copy", nil))
 	origProj, err := s.ctx.FindProjectVarsById(projectId, false)
 	s.NoError(err)
 
@@ -410,6 +497,45 @@ func (s *ProjectConnectorGetSuite) TestCopyProjectVars() {
 	s.Equal(origProj.Vars, newProj.Vars)
 }
 
+func (s *ProjectConnectorGetSuite) TestDiffProjectVars() {
+	diff, err := s.ctx.DiffProjectVars(projectId, &restModel.APIProjectVars{
+		Vars: map[string]string{
+			"a": "1",  // unchanged
+			"b": "",   // private var, redacted placeholder -> unchanged
+			"c": "10", // new
+		},
+		VarsToDelete: []string{"a"},
+	})
+	s.NoError(err)
+	s.Require().NotNil(diff)
+	s.Equal(map[string]string{"c": "10"}, diff.Added)
+	s.Empty(diff.Changed)
+	s.Equal([]string{"a"}, diff.Deleted)
+	s.ElementsMatch([]string{"a", "b"}, diff.Unchanged)
+}
+
+func (s *ProjectConnectorGetSuite) TestCopyProjectVarsSelective() {
+	s.NoError(s.ctx.CopyProjectVars(projectId, "project-copy-selective", []string{"a"}))
+	newProj, err := s.ctx.FindProjectVarsById("project-copy-selective", false)
+	s.NoError(err)
+
+	s.Equal(map[string]string{"a": "1"}, newProj.Vars)
+	s.NotContains(newProj.Vars, "b")
+}
+
+func (s *ProjectConnectorGetSuite) TestCopyProjectVarsMergesIntoExistingDestination() {
+	s.NoError(s.ctx.UpdateProjectVars("project-copy-existing", &restModel.APIProjectVars{
+		Vars: map[string]string{"existing": "keepme"},
+	}, false))
+
+	s.NoError(s.ctx.CopyProjectVars(projectId, "project-copy-existing", []string{"a"}))
+	newProj, err := s.ctx.FindProjectVarsById("project-copy-existing", false)
+	s.NoError(err)
+
+	s.Equal("1", newProj.Vars["a"])
+	s.Equal("keepme", newProj.Vars["existing"], "copying vars should not clobber the destination's existing vars")
+}
+
 func TestGetProjectAliasResults(t *testing.T) {
 	require.NoError(t, db.ClearCollections(model.ProjectAliasCollection))
 	p := model.Project{
@@ -439,14 +565,32 @@ func TestGetProjectAliasResults(t *testing.T) {
 	}
 	require.NoError(t, alias2.Upsert())
 
+	tagAlias := model.ProjectAlias{
+		Alias:     evergreen.GitTagAlias,
+		ProjectID: p.Identifier,
+		GitTag:    "^release-.*$",
+		Variant:   "^bv2$",
+		Task:      ".*",
+	}
+	require.NoError(t, tagAlias.Upsert())
+
 	dc := &DBProjectConnector{}
-	variantTasks, err := dc.GetProjectAliasResults(&p, alias1.Alias, false)
+	variantTasks, err := dc.GetProjectAliasResults(&p, alias1.Alias, false, "")
 	assert.NoError(t, err)
 	assert.Len(t, variantTasks, 1)
 	assert.Len(t, variantTasks[0].Tasks, 1)
 	assert.Equal(t, "task1", variantTasks[0].Tasks[0])
-	variantTasks, err = dc.GetProjectAliasResults(&p, alias2.Alias, false)
+	variantTasks, err = dc.GetProjectAliasResults(&p, alias2.Alias, false, "")
 	assert.NoError(t, err)
 	assert.Len(t, variantTasks, 1)
 	assert.Len(t, variantTasks[0].Tasks, 2)
+
+	variantTasks, err = dc.GetProjectAliasResults(&p, evergreen.GitTagAlias, false, "release-1.0")
+	assert.NoError(t, err)
+	assert.Len(t, variantTasks, 1)
+	assert.Len(t, variantTasks[0].Tasks, 2)
+
+	variantTasks, err = dc.GetProjectAliasResults(&p, evergreen.GitTagAlias, false, "not-a-release")
+	assert.NoError(t, err)
+	assert.Empty(t, variantTasks)
 }
@@ -36,9 +36,13 @@ func (tc *DBTaskConnector) FindTaskById(taskId string) (*task.Task, error) {
 }
 
 func (tc *DBTaskConnector) FindTaskWithinTimePeriod(startedAfter, finishedBefore time.Time,
-	project string, statuses []string) ([]task.Task, error) {
+	project string, statuses []string, startTaskId string, limit int) ([]task.Task, error) {
 
-	tasks, err := task.Find(task.WithinTimePeriod(startedAfter, finishedBefore, project, statuses))
+	q := task.WithinTimePeriod(startedAfter, finishedBefore, project, statuses, startTaskId)
+	if limit > 0 {
+		q = q.Limit(limit)
+	}
+	tasks, err := task.Find(q)
 
 	if err != nil {
 		return nil, err
@@ -175,8 +179,8 @@ func (tc *DBTaskConnector) ResetTask(taskId, username string) error {
 		"Reset task error")
 }
 
-func (tc *DBTaskConnector) AbortTask(taskId string, user string) error {
-	return serviceModel.AbortTask(taskId, user)
+func (tc *DBTaskConnector) AbortTask(taskId, user, reason string) error {
+	return serviceModel.AbortTask(taskId, user, reason)
 }
 
 func (tc *DBTaskConnector) CheckTaskSecret(taskID string, r *http.Request) (int, error) {
@@ -232,12 +236,16 @@ func (tc *DBTaskConnector) FindTasksByVersion(versionID, sortBy string, statuses
 // MockTaskConnector stores a cached set of tasks that are queried against by the
 // implementations of the Connector interface's Task related functions.
 type MockTaskConnector struct {
-	CachedTasks    []task.Task
-	CachedOldTasks []task.Task
-	Manifests      []manifest.Manifest
-	CachedAborted  map[string]string
-	StoredError    error
-	FailOnAbort    bool
+	CachedTasks          []task.Task
+	CachedOldTasks       []task.Task
+	Manifests            []manifest.Manifest
+	CachedAborted        map[string]string
+	CachedAbortedReasons map[string]string
+	StoredError          error
+	FailOnAbort          bool
+	// FailOnArchive causes ResetTask to return an error, simulating a
+	// failure to archive the task, without needing a DB.
+	FailOnArchive bool
 }
 
 // FindTaskById provides a mock implementation of the functions for the
@@ -281,8 +289,24 @@ func (mtc *MockTaskConnector) FindTasksByProjectAndCommit(projectId, commitHash,
 	return nil, nil
 }
 
-func (mtc *MockTaskConnector) FindTaskWithinTimePeriod(startedAfter, finishedBefore time.Time, project string, status []string) ([]task.Task, error) {
-	return mtc.CachedTasks, mtc.StoredError
+func (mtc *MockTaskConnector) FindTaskWithinTimePeriod(startedAfter, finishedBefore time.Time, project string, status []string, startTaskId string, limit int) ([]task.Task, error) {
+	if mtc.StoredError != nil {
+		return nil, mtc.StoredError
+	}
+
+	tasks := []task.Task{}
+	for _, t := range mtc.CachedTasks {
+		if startTaskId != "" && t.Id < startTaskId {
+			continue
+		}
+		tasks = append(tasks, t)
+	}
+
+	if limit > 0 && len(tasks) > limit {
+		tasks = tasks[:limit]
+	}
+
+	return tasks, nil
 }
 
 func (mtc *MockTaskConnector) FindOldTasksByIDWithDisplayTasks(id string) ([]task.Task, error) {
@@ -359,6 +383,9 @@ func (mtc *MockTaskConnector) SetTaskActivated(taskId, user string, activated bo
 }
 
 func (mtc *MockTaskConnector) ResetTask(taskId, username string) error {
+	if mtc.FailOnArchive {
+		return errors.New("mock failed to archive task")
+	}
 	for ix, t := range mtc.CachedTasks {
 		if t.Id == taskId {
 			t.Activated = true
@@ -404,11 +431,14 @@ func (mtc *MockTaskConnector) FindCostTaskByProject(project, taskId string,
 	return tasks, nil
 }
 
-func (tc *MockTaskConnector) AbortTask(taskId, user string) error {
+func (tc *MockTaskConnector) AbortTask(taskId, user, reason string) error {
 	if tc.FailOnAbort {
 		return errors.New("manufactured fail")
 	}
 	tc.CachedAborted[taskId] = user
+	if tc.CachedAbortedReasons != nil {
+		tc.CachedAbortedReasons[taskId] = reason
+	}
 	return nil
 }
 
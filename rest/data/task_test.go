@@ -434,24 +434,41 @@ type TaskConnectorAbortTaskSuite struct {
 func TestMockTaskConnectorAbortTaskSuite(t *testing.T) {
 	s := new(TaskConnectorAbortTaskSuite)
 	s.ctx = &MockConnector{MockTaskConnector: MockTaskConnector{
-		CachedTasks:   []task.Task{{Id: "task1"}},
-		CachedAborted: make(map[string]string),
+		CachedTasks:          []task.Task{{Id: "task1"}},
+		CachedAborted:        make(map[string]string),
+		CachedAbortedReasons: make(map[string]string),
 	}}
 	suite.Run(t, s)
 }
 
 func (s *TaskConnectorAbortTaskSuite) TestAbort() {
-	err := s.ctx.AbortTask("task1", "user1")
+	err := s.ctx.AbortTask("task1", "user1", "")
+	s.NoError(err)
+	s.Equal("user1", s.ctx.(*MockConnector).MockTaskConnector.CachedAborted["task1"])
+}
+
+func (s *TaskConnectorAbortTaskSuite) TestAbortWithReason() {
+	err := s.ctx.AbortTask("task1", "user1", "no longer needed")
 	s.NoError(err)
 	s.Equal("user1", s.ctx.(*MockConnector).MockTaskConnector.CachedAborted["task1"])
+	s.Equal("no longer needed", s.ctx.(*MockConnector).MockTaskConnector.CachedAbortedReasons["task1"])
 }
 
 func (s *TaskConnectorAbortTaskSuite) TestAbortFail() {
 	s.ctx.(*MockConnector).MockTaskConnector.FailOnAbort = true
-	err := s.ctx.AbortTask("task1", "user1")
+	err := s.ctx.AbortTask("task1", "user1", "")
 	s.Error(err)
 }
 
+func TestMockResetTaskFailOnArchive(t *testing.T) {
+	assert := assert.New(t)
+	mc := &MockTaskConnector{
+		CachedTasks:   []task.Task{{Id: "task1"}},
+		FailOnArchive: true,
+	}
+	assert.Error(mc.ResetTask("task1", "user1"))
+}
+
 func TestCheckTaskSecret(t *testing.T) {
 	assert := assert.New(t)
 	assert.NoError(db.ClearCollections(task.Collection))
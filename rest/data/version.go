@@ -76,8 +76,9 @@ func (vc *DBVersionConnector) AbortVersion(versionId, caller string) error {
 // RestartVersion wraps the service level RestartVersion, which restarts
 // completed tasks associated with a given versionId. If abortInProgress is
 // true, it also sets the abort flag on any in-progress tasks. In addition, it
-// updates all builds containing the tasks affected.
-func (vc *DBVersionConnector) RestartVersion(versionId string, caller string) error {
+// updates all builds containing the tasks affected. If failedOnly is true,
+// only tasks that failed are restarted.
+func (vc *DBVersionConnector) RestartVersion(versionId string, caller string, failedOnly bool) error {
 	// Get a list of all tasks of the given versionId
 	tasks, err := task.Find(task.ByVersion(versionId))
 	if err != nil {
@@ -91,6 +92,9 @@ func (vc *DBVersionConnector) RestartVersion(versionId string, caller string) er
 	}
 	var taskIds []string
 	for _, task := range tasks {
+		if failedOnly && !evergreen.IsFailedTaskStatus(task.Status) {
+			continue
+		}
 		taskIds = append(taskIds, task.Id)
 	}
 	return model.RestartVersion(versionId, taskIds, true, caller)
@@ -411,7 +415,7 @@ func (mvc *MockVersionConnector) AbortVersion(versionId, caller string) error {
 // The main function of the RestartVersion() for the MockVersionConnector is to
 // test connectivity. It sets the value of versionId in CachedRestartedVersions
 // to the caller.
-func (mvc *MockVersionConnector) RestartVersion(versionId string, caller string) error {
+func (mvc *MockVersionConnector) RestartVersion(versionId string, caller string, failedOnly bool) error {
 	mvc.CachedRestartedVersions[versionId] = caller
 	return nil
 }
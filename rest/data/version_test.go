@@ -205,17 +205,17 @@ func (s *VersionConnectorSuite) TestAbortVersion() {
 func (s *VersionConnectorSuite) TestRestartVersion() {
 	if s.isMock {
 		// Testing with versions that have tasks under them should succeed.
-		err := s.ctx.RestartVersion("version1", "caller1")
+		err := s.ctx.RestartVersion("version1", "caller1", false)
 		s.NoError(err)
 		s.Equal(s.ctx.(*MockConnector).CachedRestartedVersions["version1"], "caller1")
 
-		err = s.ctx.RestartVersion("version2", "caller2")
+		err = s.ctx.RestartVersion("version2", "caller2", false)
 		s.NoError(err)
 		s.Equal(s.ctx.(*MockConnector).CachedRestartedVersions["version2"], "caller2")
 
 	} else {
 		versionId := "version3"
-		err := s.ctx.RestartVersion(versionId, "caller3")
+		err := s.ctx.RestartVersion(versionId, "caller3", false)
 		s.NoError(err)
 
 		// When a version is restarted, all of its completed tasks should be reset.
@@ -1278,6 +1278,7 @@ type APIAWSConfig struct {
 	S3                   *APIS3Credentials `json:"s3_credentials"`
 	TaskSync             *APIS3Credentials `json:"task_sync"`
 	TaskSyncRead         *APIS3Credentials `json:"task_sync_read"`
+	TaskSyncReadRoleARN  *string           `json:"task_sync_read_role_arn"`
 	DefaultSecurityGroup *string           `json:"default_security_group"`
 	AllowedInstanceTypes []*string         `json:"allowed_instance_types"`
 	AllowedRegions       []*string         `json:"allowed_regions"`
@@ -1349,6 +1350,7 @@ func (a *APIAWSConfig) BuildFromService(h interface{}) error {
 			return errors.Wrap(err, "could not convert API S3 credentials to service")
 		}
 		a.TaskSyncRead = taskSyncRead
+		a.TaskSyncReadRoleARN = ToStringPtr(v.TaskSyncReadRoleARN)
 
 		a.DefaultSecurityGroup = ToStringPtr(v.DefaultSecurityGroup)
 		a.MaxVolumeSizePerUser = &v.MaxVolumeSizePerUser
@@ -1412,6 +1414,7 @@ func (a *APIAWSConfig) ToService() (interface{}, error) {
 		}
 	}
 	config.TaskSyncRead = taskSyncRead
+	config.TaskSyncReadRoleARN = FromStringPtr(a.TaskSyncReadRoleARN)
 
 	if a.MaxVolumeSizePerUser != nil {
 		config.MaxVolumeSizePerUser = *a.MaxVolumeSizePerUser
@@ -2,9 +2,11 @@ package model
 
 import (
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
+	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/pkg/errors"
 )
 
@@ -80,6 +82,24 @@ func (item *APICommitQueueItem) BuildFromService(h interface{}) error {
 	return nil
 }
 
+// BuildFromServiceWithPatch behaves like BuildFromService, but additionally populates
+// the Patch field from the given patch.Patch. Populating Patch requires the caller to
+// have already looked up the patch by item.Version, so it's kept separate from the
+// cheap, lookup-free BuildFromService path rather than done unconditionally.
+func (item *APICommitQueueItem) BuildFromServiceWithPatch(h interface{}, p patch.Patch) error {
+	if err := item.BuildFromService(h); err != nil {
+		return err
+	}
+
+	apiPatch := &APIPatch{}
+	if err := apiPatch.BuildFromService(p); err != nil {
+		return errors.Wrap(err, "can't build API patch from db model")
+	}
+	item.Patch = apiPatch
+
+	return nil
+}
+
 func (item *APICommitQueueItem) ToService() (interface{}, error) {
 	serviceItem := commitqueue.CommitQueueItem{
 		Issue:           FromStringPtr(item.Issue),
@@ -104,6 +124,11 @@ type GithubCommentCqData struct {
 func ParseGitHubComment(comment string) GithubCommentCqData {
 	data := GithubCommentCqData{}
 
+	// Normalize Windows-style line endings so the parsing below, which assumes \n
+	// separators, doesn't leak stray \r characters into the message override.
+	comment = strings.ReplaceAll(comment, "\r\n", "\n")
+	comment = strings.ReplaceAll(comment, "\r", "")
+
 	lineRegex := regexp.MustCompile(`(\A.*)(?:\n*)([\S\s]*)`)
 	lines := lineRegex.FindAllStringSubmatch(comment, -1)
 	if len(lines) == 0 {
@@ -122,13 +147,25 @@ func ParseGitHubComment(comment string) GithubCommentCqData {
 
 func parseFirstLine(comment string) GithubCommentCqData {
 	modules := []APIModule{}
+	moduleIndexByName := map[string]int{}
 
-	moduleRegex := regexp.MustCompile(`(?:--module|-m)\s+(\w+):(\d+)`)
+	// Accept both the space-separated ("--module name:issue") and the combined,
+	// equals-separated ("--module=name:issue") flag syntax.
+	moduleRegex := regexp.MustCompile(`(?:--module|-m)(?:\s+|=)(\w+):(\d+)`)
 	moduleSlices := moduleRegex.FindAllStringSubmatch(comment, -1)
 	for _, moduleSlice := range moduleSlices {
+		name := moduleSlice[1]
+		issue := moduleSlice[2]
+		if index, ok := moduleIndexByName[name]; ok {
+			// The same module name appearing more than once is most likely a user
+			// correcting themselves, so the last occurrence wins.
+			modules[index].Issue = ToStringPtr(issue)
+			continue
+		}
+		moduleIndexByName[name] = len(modules)
 		modules = append(modules, APIModule{
-			Module: ToStringPtr(moduleSlice[1]),
-			Issue:  ToStringPtr(moduleSlice[2]),
+			Module: ToStringPtr(name),
+			Issue:  ToStringPtr(issue),
 		})
 	}
 
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/evergreen-ci/evergreen/model/commitqueue"
+	"github.com/evergreen-ci/evergreen/model/patch"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,6 +42,25 @@ func TestCommitQueueBuildFromService(t *testing.T) {
 	assert.Equal(cq.Queue[0].Modules[0].Issue, FromStringPtr(cqAPI.Queue[0].Modules[0].Issue))
 }
 
+func TestCommitQueueItemBuildFromServiceWithPatch(t *testing.T) {
+	assert := assert.New(t)
+	item := commitqueue.CommitQueueItem{
+		Issue:   "1",
+		Version: "myVersion",
+	}
+	p := patch.Patch{
+		Version:     "myVersion",
+		Description: "my patch description",
+	}
+
+	itemAPI := APICommitQueueItem{}
+	assert.NoError(itemAPI.BuildFromServiceWithPatch(item, p))
+	assert.Equal(item.Issue, FromStringPtr(itemAPI.Issue))
+	assert.Equal(item.Version, FromStringPtr(itemAPI.Version))
+	assert.NotNil(itemAPI.Patch)
+	assert.Equal(p.Description, FromStringPtr(itemAPI.Patch.Description))
+}
+
 func TestParseGitHubComment(t *testing.T) {
 	assert := assert.New(t)
 
@@ -91,4 +111,28 @@ some more lines
 	assert.Equal(`this is my commit message
 some more lines
     extra whitespace  `, data.MessageOverride)
+
+	comment = "evergreen merge --module module1:1234\r\n\r\nthis is my commit message\r\nsome more lines\r\n"
+	data = ParseGitHubComment(comment)
+	assert.Len(data.Modules, 1)
+	assert.Equal(ToStringPtr("module1"), data.Modules[0].Module)
+	assert.Equal(ToStringPtr("1234"), data.Modules[0].Issue)
+	assert.NotContains(data.MessageOverride, "\r")
+	assert.Equal("this is my commit message\nsome more lines\n", data.MessageOverride)
+
+	comment = "evergreen merge --module=module1:1234 -m=module2:3456"
+	data = ParseGitHubComment(comment)
+	assert.Len(data.Modules, 2)
+	assert.Equal(ToStringPtr("module1"), data.Modules[0].Module)
+	assert.Equal(ToStringPtr("1234"), data.Modules[0].Issue)
+	assert.Equal(ToStringPtr("module2"), data.Modules[1].Module)
+	assert.Equal(ToStringPtr("3456"), data.Modules[1].Issue)
+
+	comment = "evergreen merge --module module1:1234 --module module2:3456 --module module1:5678"
+	data = ParseGitHubComment(comment)
+	assert.Len(data.Modules, 2)
+	assert.Equal(ToStringPtr("module1"), data.Modules[0].Module)
+	assert.Equal(ToStringPtr("5678"), data.Modules[0].Issue, "duplicate module name should keep the last issue")
+	assert.Equal(ToStringPtr("module2"), data.Modules[1].Module)
+	assert.Equal(ToStringPtr("3456"), data.Modules[1].Issue)
 }
@@ -14,6 +14,7 @@ import (
 type APIHost struct {
 	Id                    *string     `json:"host_id"`
 	HostURL               *string     `json:"host_url"`
+	IP                    *string     `json:"ip_address"`
 	Tag                   *string     `json:"tag"`
 	Distro                DistroInfo  `json:"distro"`
 	Provisioned           bool        `json:"provisioned"`
@@ -119,6 +120,7 @@ func (apiHost *APIHost) buildFromHostStruct(h interface{}) error {
 	}
 	apiHost.Id = ToStringPtr(v.Id)
 	apiHost.HostURL = ToStringPtr(v.Host)
+	apiHost.IP = ToStringPtr(v.IP)
 	apiHost.Tag = ToStringPtr(v.Tag)
 	apiHost.Provisioned = v.Provisioned
 	apiHost.StartedBy = ToStringPtr(v.StartedBy)
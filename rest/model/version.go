@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/patch"
+	"github.com/mongodb/grip"
 	"github.com/pkg/errors"
 )
 
@@ -77,7 +79,47 @@ func (apiVersion *APIVersion) BuildFromService(h interface{}) error {
 	return nil
 }
 
-// ToService returns a service layer build using the data from the APIVersion.
+// ToService returns a service layer version using the data from the APIVersion.
 func (apiVersion *APIVersion) ToService() (interface{}, error) {
-	return nil, errors.New("not implemented for read-only route")
+	var err error
+	v := model.Version{}
+	catcher := grip.NewBasicCatcher()
+	v.Id = FromStringPtr(apiVersion.Id)
+	v.CreateTime, err = FromTimePtr(apiVersion.CreateTime)
+	catcher.Add(err)
+	v.StartTime, err = FromTimePtr(apiVersion.StartTime)
+	catcher.Add(err)
+	v.FinishTime, err = FromTimePtr(apiVersion.FinishTime)
+	catcher.Add(err)
+	v.Revision = FromStringPtr(apiVersion.Revision)
+	v.RevisionOrderNumber = apiVersion.Order
+	v.Identifier = FromStringPtr(apiVersion.Project)
+	v.Author = FromStringPtr(apiVersion.Author)
+	v.AuthorEmail = FromStringPtr(apiVersion.AuthorEmail)
+	v.Message = FromStringPtr(apiVersion.Message)
+	v.Status = FromStringPtr(apiVersion.Status)
+	v.Repo = FromStringPtr(apiVersion.Repo)
+	v.Branch = FromStringPtr(apiVersion.Branch)
+	v.Requester = FromStringPtr(apiVersion.Requester)
+	v.Errors = FromStringPtrSlice(apiVersion.Errors)
+
+	var bd model.VersionBuildStatus
+	for _, t := range apiVersion.BuildVariants {
+		bd = model.VersionBuildStatus{
+			BuildVariant: FromStringPtr(t.BuildVariant),
+			BuildId:      FromStringPtr(t.BuildId),
+		}
+		v.BuildVariants = append(v.BuildVariants, bd)
+	}
+
+	params := []patch.Parameter{}
+	for _, param := range apiVersion.Parameters {
+		params = append(params, patch.Parameter{
+			Key:   FromStringPtr(param.Key),
+			Value: FromStringPtr(param.Value),
+		})
+	}
+	v.Parameters = params
+
+	return v, catcher.Resolve()
 }
@@ -82,8 +82,48 @@ func TestVersionBuildFromService(t *testing.T) {
 
 func TestVersionToService(t *testing.T) {
 	assert := assert.New(t)
-	apiVersion := &APIVersion{}
-	v, err := apiVersion.ToService()
-	assert.Nil(v)
-	assert.Error(err)
+
+	createTime := time.Now()
+	apiVersion := &APIVersion{
+		Id:          ToStringPtr("versionId"),
+		CreateTime:  &createTime,
+		Revision:    ToStringPtr("revision"),
+		Order:       5,
+		Project:     ToStringPtr("project"),
+		Author:      ToStringPtr("author"),
+		AuthorEmail: ToStringPtr("author_email"),
+		Message:     ToStringPtr("message"),
+		Status:      ToStringPtr("status"),
+		Repo:        ToStringPtr("repo"),
+		Branch:      ToStringPtr("branch"),
+		Requester:   ToStringPtr("requester"),
+		Errors:      ToStringPtrSlice([]string{"made a mistake"}),
+		BuildVariants: []buildDetail{
+			{
+				BuildVariant: ToStringPtr("buildvariant1"),
+				BuildId:      ToStringPtr("buildId1"),
+			},
+		},
+	}
+
+	i, err := apiVersion.ToService()
+	assert.NoError(err)
+	v, ok := i.(model.Version)
+	assert.True(ok)
+	assert.Equal("versionId", v.Id)
+	assert.Equal(createTime, v.CreateTime)
+	assert.Equal("revision", v.Revision)
+	assert.Equal(5, v.RevisionOrderNumber)
+	assert.Equal("project", v.Identifier)
+	assert.Equal("author", v.Author)
+	assert.Equal("author_email", v.AuthorEmail)
+	assert.Equal("message", v.Message)
+	assert.Equal("status", v.Status)
+	assert.Equal("repo", v.Repo)
+	assert.Equal("branch", v.Branch)
+	assert.Equal("requester", v.Requester)
+	assert.Equal([]string{"made a mistake"}, v.Errors)
+	assert.Len(v.BuildVariants, 1)
+	assert.Equal("buildvariant1", v.BuildVariants[0].BuildVariant)
+	assert.Equal("buildId1", v.BuildVariants[0].BuildId)
 }
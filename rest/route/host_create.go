@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/docker/docker/api/types"
@@ -67,6 +68,7 @@ func (h *hostCreateHandler) Run(ctx context.Context) gimlet.Responder {
 	for i := 0; i < numHosts; i++ {
 		intentHost, err := h.sc.MakeIntentHost(h.taskID, "", "", h.createHost)
 		if err != nil {
+			h.rollBackIntentHosts(ids)
 			return gimlet.MakeJSONErrorResponder(err)
 		}
 		ids = append(ids, intentHost.Id)
@@ -80,8 +82,24 @@ func (h *hostCreateHandler) Run(ctx context.Context) gimlet.Responder {
 	return gimlet.NewJSONResponse(ids)
 }
 
+// rollBackIntentHosts removes intent hosts already created for this request
+// after a later host in the batch fails, so a partial failure doesn't leave
+// orphaned intent hosts behind.
+func (h *hostCreateHandler) rollBackIntentHosts(ids []string) {
+	for _, id := range ids {
+		if err := host.RemoveStrict(id); err != nil {
+			grip.Error(message.WrapError(err, message.Fields{
+				"message": "could not roll back intent host after host.create batch failure",
+				"host_id": id,
+				"task_id": h.taskID,
+			}))
+		}
+	}
+}
+
 type hostListHandler struct {
 	taskID string
+	status string
 
 	sc data.Connector
 }
@@ -101,12 +119,13 @@ func (h *hostListHandler) Parse(ctx context.Context, r *http.Request) error {
 		}
 	}
 	h.taskID = taskID
+	h.status = r.FormValue("status")
 
 	return nil
 }
 
 func (h *hostListHandler) Run(ctx context.Context) gimlet.Responder {
-	hosts, err := h.sc.ListHostsForTask(ctx, h.taskID)
+	hosts, err := h.sc.ListHostsForTask(ctx, h.taskID, h.status)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(err)
 	}
@@ -133,6 +152,10 @@ type containerLogsHandler struct {
 	startTime string
 	endTime   string
 	tail      string
+	follow    bool
+	// streamAll, when set via the "stream=all" query param, requests both
+	// stdout and stderr interleaved, overriding isError.
+	streamAll bool
 
 	host *host.Host
 
@@ -166,8 +189,10 @@ func (h *containerLogsHandler) Parse(ctx context.Context, r *http.Request) error
 	}
 	h.host = host
 
+	var parsedStart, parsedEnd time.Time
 	if startTime := r.FormValue("start_time"); startTime != "" {
-		if _, err := time.Parse(time.RFC3339, startTime); err != nil {
+		parsedStart, err = time.Parse(time.RFC3339, startTime)
+		if err != nil {
 			return gimlet.ErrorResponse{
 				StatusCode: http.StatusBadRequest,
 				Message: fmt.Sprintf("problem parsing start time from '%s' (%s). Format must be RFC339",
@@ -177,7 +202,8 @@ func (h *containerLogsHandler) Parse(ctx context.Context, r *http.Request) error
 		h.startTime = startTime
 	}
 	if endTime := r.FormValue("end_time"); endTime != "" {
-		if _, err := time.Parse(time.RFC3339, endTime); err != nil {
+		parsedEnd, err = time.Parse(time.RFC3339, endTime)
+		if err != nil {
 			return gimlet.ErrorResponse{
 				StatusCode: http.StatusBadRequest,
 				Message: fmt.Sprintf("problem parsing end time from '%s' (%s). Format must be RFC339",
@@ -186,6 +212,14 @@ func (h *containerLogsHandler) Parse(ctx context.Context, r *http.Request) error
 		}
 		h.endTime = endTime
 	}
+	if h.startTime != "" && h.endTime != "" && parsedStart.After(parsedEnd) {
+		return gimlet.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    fmt.Sprintf("start_time '%s' must not be after end_time '%s'", h.startTime, h.endTime),
+		}
+	}
+	h.follow = r.FormValue("follow") == "true"
+	h.streamAll = r.FormValue("stream") == "all"
 	if tailStr := r.FormValue("tail"); tailStr != "" {
 		tail, err := strconv.Atoi(tailStr)
 		if (err == nil && tail >= 0) || (err != nil && tailStr == "all") {
@@ -203,7 +237,7 @@ func (h *containerLogsHandler) Parse(ctx context.Context, r *http.Request) error
 }
 
 func (h *containerLogsHandler) Run(ctx context.Context) gimlet.Responder {
-	parent, err := h.host.GetParent()
+	parent, err := h.host.GetParentCached()
 	if err != nil {
 		return gimlet.NewJSONErrorResponse(errors.Wrapf(err, "error finding parent for container _id %s", h.host.Id))
 	}
@@ -216,12 +250,19 @@ func (h *containerLogsHandler) Run(ctx context.Context) gimlet.Responder {
 		Tail:       h.tail,
 		Since:      h.startTime,
 		Until:      h.endTime,
+		Follow:     h.follow,
 	}
-	if h.isError {
+	if h.streamAll {
+		options.ShowStdout = true
+		options.ShowStderr = true
+	} else if h.isError {
 		options.ShowStderr = true
 	} else {
 		options.ShowStdout = true
 	}
+	// GetDockerLogs returns a live reader immediately; when Follow is set,
+	// reading from it streams new log output as it arrives until the
+	// container's log stream ends or ctx is canceled.
 	logs, err := h.sc.GetDockerLogs(ctx, h.host.Id, parent, settings, options)
 	if err != nil {
 		return gimlet.NewJSONErrorResponse(errors.Wrap(err, "error getting docker logs"))
@@ -267,18 +308,54 @@ func (h *containerStatusHandler) Parse(ctx context.Context, r *http.Request) err
 	return nil
 }
 
+// containerStatusErrorCode identifies the category of error encountered
+// while fetching a container's status, so the UI can branch on it without
+// parsing the message.
+type containerStatusErrorCode string
+
+const (
+	containerStatusErrorParentNotFound      containerStatusErrorCode = "parent_not_found"
+	containerStatusErrorContainerNotRunning containerStatusErrorCode = "container_not_running"
+	containerStatusErrorDockerAPI           containerStatusErrorCode = "docker_api_error"
+)
+
+// containerStatusError is a structured error response for containerStatusHandler,
+// distinguishing failure categories that require different UI handling.
+type containerStatusError struct {
+	Code    containerStatusErrorCode `json:"code"`
+	Message string                   `json:"message"`
+}
+
+// newContainerStatusErrorResponse builds a JSON response carrying a
+// structured containerStatusError at the given status code.
+func newContainerStatusErrorResponse(statusCode int, code containerStatusErrorCode, err error) gimlet.Responder {
+	resp := gimlet.NewResponseBuilder()
+	_ = resp.SetStatus(statusCode)
+	_ = resp.AddData(containerStatusError{Code: code, Message: err.Error()})
+	return resp
+}
+
 func (h *containerStatusHandler) Run(ctx context.Context) gimlet.Responder {
-	parent, err := h.host.GetParent()
+	parent, err := h.host.GetParentCached()
 	if err != nil {
-		return gimlet.NewJSONErrorResponse(errors.Wrapf(err, "error finding parent for container _id %s", h.host.Id))
+		err = errors.Wrapf(err, "error finding parent for container _id %s", h.host.Id)
+		if strings.Contains(err.Error(), host.ErrorParentNotFound) {
+			return newContainerStatusErrorResponse(http.StatusNotFound, containerStatusErrorParentNotFound, err)
+		}
+		return newContainerStatusErrorResponse(http.StatusInternalServerError, containerStatusErrorDockerAPI, err)
 	}
 	settings, err := evergreen.GetConfig()
 	if err != nil {
-		return gimlet.NewJSONErrorResponse(errors.Wrap(err, "error getting settings config"))
+		return newContainerStatusErrorResponse(http.StatusInternalServerError, containerStatusErrorDockerAPI,
+			errors.Wrap(err, "error getting settings config"))
 	}
 	status, err := h.sc.GetDockerStatus(ctx, h.host.Id, parent, settings)
 	if err != nil {
-		return gimlet.NewJSONErrorResponse(errors.Wrap(err, "error getting docker status"))
+		err = errors.Wrap(err, "error getting docker status")
+		if strings.Contains(err.Error(), "No such container") || strings.Contains(err.Error(), "not running") {
+			return newContainerStatusErrorResponse(http.StatusConflict, containerStatusErrorContainerNotRunning, err)
+		}
+		return newContainerStatusErrorResponse(http.StatusBadGateway, containerStatusErrorDockerAPI, err)
 	}
 	return gimlet.NewJSONResponse(status)
 }
@@ -5,6 +5,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strconv"
 	"testing"
 	"time"
 
@@ -292,6 +293,68 @@ func TestMakeIntentHost(t *testing.T) {
 	assert.Equal(ec2Settings2.AMI, "ami-987654")
 }
 
+func TestMakeIntentHostRejectsTooManyHosts(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	require.NoError(db.ClearCollections(distro.Collection, host.Collection, task.Collection))
+	handler := hostCreateHandler{
+		sc: &data.DBConnector{},
+	}
+
+	d := distro.Distro{
+		Id:       "archlinux-test",
+		Provider: evergreen.ProviderNameEc2OnDemand,
+		ProviderSettingsList: []*birch.Document{birch.NewDocument(
+			birch.EC.String("ami", "ami-123456"),
+			birch.EC.String("region", "us-east-1"),
+			birch.EC.String("instance_type", "t1.micro"),
+			birch.EC.String("subnet_id", "subnet-12345678"),
+			birch.EC.SliceString("security_group_ids", []string{"abcdef"}),
+		)},
+	}
+	require.NoError(d.Insert())
+
+	sampleTask := &task.Task{
+		Id: "task-id",
+	}
+	require.NoError(sampleTask.Insert())
+
+	c := apimodels.CreateHost{
+		Distro:              "archlinux-test",
+		CloudProvider:       "ec2",
+		NumHosts:            strconv.Itoa(apimodels.MaxNumHosts + 1),
+		Scope:               "task",
+		SetupTimeoutSecs:    600,
+		TeardownTimeoutSecs: 21600,
+		KeyName:             "mock_key",
+	}
+	handler.taskID = "task-id"
+	handler.createHost = c
+
+	assert.Error(handler.createHost.Validate())
+
+	hosts, err := host.Find(host.IsUninitialized)
+	assert.NoError(err)
+	assert.Empty(hosts, "no intent host should be created for a rejected num_hosts value")
+}
+
+func TestHostCreateHandlerRollsBackIntentHosts(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	require.NoError(db.ClearCollections(host.Collection))
+	h1 := &host.Host{Id: "intent-1"}
+	h2 := &host.Host{Id: "intent-2"}
+	require.NoError(h1.Insert())
+	require.NoError(h2.Insert())
+
+	handler := hostCreateHandler{taskID: "task-id"}
+	handler.rollBackIntentHosts([]string{h1.Id, h2.Id})
+
+	hosts, err := host.Find(db.Q{})
+	assert.NoError(err)
+	assert.Empty(hosts, "intent hosts created earlier in the batch should be removed on rollback")
+}
+
 func TestHostCreateDocker(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -459,6 +522,31 @@ func TestGetDockerLogs(t *testing.T) {
 	assert.NoError(err)
 	assert.Contains(logs.String(), "this is a log message")
 
+	// follow param
+	url = fmt.Sprintf("/hosts/%s/logs/output?follow=true", h.Id)
+	request, err = http.NewRequest("GET", url, bytes.NewReader(nil))
+	assert.NoError(err)
+	request = gimlet.SetURLVars(request, options)
+	assert.NoError(handler.Parse(context.Background(), request))
+	assert.True(handler.follow)
+
+	// stream=all param
+	url = fmt.Sprintf("/hosts/%s/logs/output?stream=all", h.Id)
+	request, err = http.NewRequest("GET", url, bytes.NewReader(nil))
+	assert.NoError(err)
+	request = gimlet.SetURLVars(request, options)
+	assert.NoError(handler.Parse(context.Background(), request))
+	assert.True(handler.streamAll)
+
+	// inverted start/end time range
+	startTime = time.Now().Format(time.RFC3339)
+	endTime = time.Now().Add(-time.Minute).Format(time.RFC3339)
+	url = fmt.Sprintf("/hosts/%s/logs/output?start_time=%s&end_time=%s", h.Id, startTime, endTime)
+
+	request, err = http.NewRequest("GET", url, bytes.NewReader(nil))
+	assert.NoError(err)
+	request = gimlet.SetURLVars(request, options)
+	assert.Error(handler.Parse(context.Background(), request))
 }
 
 func TestGetDockerStatus(t *testing.T) {
@@ -533,4 +621,79 @@ func TestGetDockerStatus(t *testing.T) {
 	require.NotNil(status)
 	require.True(status.HasStarted)
 
+	// Run on a container whose parent can't be found returns a structured
+	// error with a "parent_not_found" code and a 404 status.
+	orphanHost := &host.Host{
+		Id:       "orphan-container",
+		ParentID: "does-not-exist",
+	}
+	require.NoError(orphanHost.Insert())
+	orphanHandler := containerStatusHandler{
+		sc:   &data.MockConnector{},
+		host: orphanHost,
+	}
+	res = orphanHandler.Run(context.Background())
+	require.NotNil(res)
+	assert.Equal(http.StatusNotFound, res.Status())
+	containerErr, ok := res.Data().(containerStatusError)
+	require.True(ok)
+	assert.Equal(containerStatusErrorParentNotFound, containerErr.Code)
+}
+
+func TestGetDockerLogsFailure(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	require.NoError(db.ClearCollections(distro.Collection, host.Collection, evergreen.ConfigCollection))
+	assert.NoError(evergreen.UpdateConfig(&evergreen.Settings{}))
+
+	parentHost := &host.Host{
+		Id:            "host1",
+		Host:          "host",
+		Status:        evergreen.HostRunning,
+		HasContainers: true,
+	}
+	require.NoError(parentHost.Insert())
+
+	containerHost := &host.Host{
+		Id:       "container1",
+		ParentID: parentHost.Id,
+	}
+	require.NoError(containerHost.Insert())
+
+	handler := containerLogsHandler{
+		sc:   &data.MockConnector{MockCreateHostConnector: data.MockCreateHostConnector{FailOnDockerLogs: true}},
+		host: containerHost,
+	}
+	res := handler.Run(context.Background())
+	require.NotNil(res)
+	assert.NotEqual(http.StatusOK, res.Status())
+}
+
+func TestGetDockerStatusFailure(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+	require.NoError(db.ClearCollections(distro.Collection, host.Collection, evergreen.ConfigCollection))
+	assert.NoError(evergreen.UpdateConfig(&evergreen.Settings{}))
+
+	parentHost := &host.Host{
+		Id:            "host1",
+		Host:          "host",
+		Status:        evergreen.HostRunning,
+		HasContainers: true,
+	}
+	require.NoError(parentHost.Insert())
+
+	containerHost := &host.Host{
+		Id:       "container1",
+		ParentID: parentHost.Id,
+	}
+	require.NoError(containerHost.Insert())
+
+	handler := containerStatusHandler{
+		sc:   &data.MockConnector{MockCreateHostConnector: data.MockCreateHostConnector{FailOnDockerStatus: true}},
+		host: containerHost,
+	}
+	res := handler.Run(context.Background())
+	require.NotNil(res)
+	assert.NotEqual(http.StatusOK, res.Status())
 }
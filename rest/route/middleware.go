@@ -465,6 +465,16 @@ func (m *CommitQueueItemOwnerMiddleware) ServeHTTP(rw http.ResponseWriter, r *ht
 }
 
 func RequiresProjectPermission(permission string, level evergreen.PermissionLevel) gimlet.Middleware {
+	return requiresProjectPermissionWithResourceFunc(permission, level, urlVarsToProjectScopes)
+}
+
+// requiresProjectPermissionWithResourceFunc builds a project permission
+// middleware like RequiresProjectPermission, but resolving the project(s) to
+// check with the given resourceFunc instead of always using
+// urlVarsToProjectScopes. This is for routes whose project can't be
+// determined from URL vars or query params (e.g. it's embedded in the
+// request body).
+func requiresProjectPermissionWithResourceFunc(permission string, level evergreen.PermissionLevel, resourceFunc gimlet.FindResourceFunc) gimlet.Middleware {
 	defaultRoles, err := evergreen.GetEnvironment().RoleManager().GetRoles(evergreen.UnauthedUserRoles)
 	if err != nil {
 		grip.Critical(message.WrapError(err, message.Fields{
@@ -477,7 +487,7 @@ func RequiresProjectPermission(permission string, level evergreen.PermissionLeve
 		PermissionKey: permission,
 		ResourceType:  evergreen.ProjectResourceType,
 		RequiredLevel: level.Value,
-		ResourceFunc:  urlVarsToProjectScopes,
+		ResourceFunc:  resourceFunc,
 		DefaultRoles:  defaultRoles,
 	}
 
@@ -432,7 +432,7 @@ func (p *patchRestartHandler) Run(ctx context.Context) gimlet.Responder {
 	// If the version has not been finalized, returns NotFound
 	usr := MustHaveUser(ctx)
 
-	if err := p.sc.RestartVersion(p.patchId, usr.Id); err != nil {
+	if err := p.sc.RestartVersion(p.patchId, usr.Id, false); err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "Restart error"))
 	}
 
@@ -24,10 +24,11 @@ import (
 )
 
 type projectGetHandler struct {
-	key   string
-	limit int
-	user  *user.DBUser
-	sc    data.Connector
+	key         string
+	limit       int
+	onlyEnabled bool
+	user        *user.DBUser
+	sc          data.Connector
 }
 
 func makeFetchProjectsRoute(sc data.Connector) gimlet.RouteHandler {
@@ -53,12 +54,13 @@ func (p *projectGetHandler) Parse(ctx context.Context, r *http.Request) error {
 	if err != nil {
 		return errors.WithStack(err)
 	}
+	p.onlyEnabled = (vals.Get("enabled") == "true")
 
 	return nil
 }
 
 func (p *projectGetHandler) Run(ctx context.Context) gimlet.Responder {
-	projects, err := p.sc.FindProjects(p.key, p.limit+1, 1)
+	projects, pageInfo, err := p.sc.FindProjects(p.key, p.limit, 1, p.onlyEnabled)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "Database error"))
 	}
@@ -75,17 +77,14 @@ func (p *projectGetHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(err)
 	}
 
-	lastIndex := len(projects)
-	if len(projects) > p.limit {
-		lastIndex = p.limit
-
+	if pageInfo.HasMore {
 		err = resp.SetPages(&gimlet.ResponsePages{
 			Next: &gimlet.Page{
 				Relation:        "next",
 				LimitQueryParam: "limit",
 				KeyQueryParam:   "start_at",
 				BaseURL:         p.sc.GetURL(),
-				Key:             projects[p.limit].Id,
+				Key:             pageInfo.NextKey,
 				Limit:           p.limit,
 			},
 		})
@@ -94,7 +93,6 @@ func (p *projectGetHandler) Run(ctx context.Context) gimlet.Responder {
 				"problem paginating response"))
 		}
 	}
-	projects = projects[:lastIndex]
 
 	for _, proj := range projects {
 		projectModel := &model.APIProjectRef{}
@@ -903,6 +901,7 @@ type GetProjectAliasResultsHandler struct {
 	version             string
 	alias               string
 	includeDependencies bool
+	tag                 string
 
 	sc data.Connector
 }
@@ -931,6 +930,7 @@ func (p *GetProjectAliasResultsHandler) Parse(ctx context.Context, r *http.Reque
 		return errors.New("'alias' parameter must be specified")
 	}
 	p.includeDependencies = (params.Get("include_deps") == "true")
+	p.tag = params.Get("tag")
 
 	return nil
 }
@@ -944,7 +944,7 @@ func (p *GetProjectAliasResultsHandler) Run(ctx context.Context) gimlet.Responde
 		return gimlet.MakeJSONInternalErrorResponder(errors.New("unable to get project from version"))
 	}
 
-	variantTasks, err := p.sc.GetProjectAliasResults(proj, p.alias, p.includeDependencies)
+	variantTasks, err := p.sc.GetProjectAliasResults(proj, p.alias, p.includeDependencies, p.tag)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(err)
 	}
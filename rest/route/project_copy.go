@@ -85,7 +85,7 @@ func (p *projectCopyHandler) Run(ctx context.Context) gimlet.Responder {
 	}
 
 	// copy variables, aliases, and subscriptions
-	if err = p.sc.CopyProjectVars(oldId, projectToCopy.Id); err != nil {
+	if err = p.sc.CopyProjectVars(oldId, projectToCopy.Id, nil); err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrapf(err, "error copying project vars from project '%s'", p.oldProject))
 	}
 	if err = p.sc.CopyProjectAliases(oldId, projectToCopy.Id); err != nil {
@@ -6,6 +6,9 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/evergreen-ci/evergreen"
@@ -23,6 +26,8 @@ import (
 type taskGetHandler struct {
 	taskID             string
 	fetchAllExecutions bool
+	maxExecutions      int
+	artifactName       string
 	sc                 data.Connector
 }
 
@@ -42,6 +47,20 @@ func (tgh *taskGetHandler) Factory() gimlet.RouteHandler {
 func (tgh *taskGetHandler) Parse(ctx context.Context, r *http.Request) error {
 	tgh.taskID = gimlet.GetVars(r)["task_id"]
 	_, tgh.fetchAllExecutions = r.URL.Query()["fetch_all_executions"]
+
+	if maxExecutions := r.URL.Query().Get("max_executions"); maxExecutions != "" {
+		n, err := strconv.Atoi(maxExecutions)
+		if err != nil {
+			return errors.Wrap(err, "invalid 'max_executions' query parameter")
+		}
+		if n <= 0 {
+			return errors.New("'max_executions' must be a positive integer")
+		}
+		tgh.maxExecutions = n
+	}
+
+	tgh.artifactName = r.URL.Query().Get("artifact_name")
+
 	return nil
 }
 
@@ -71,6 +90,12 @@ func (tgh *taskGetHandler) Run(ctx context.Context) gimlet.Responder {
 			return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "API model error"))
 		}
 
+		// Old executions are stored oldest first; when capped, keep only the
+		// most recent tgh.maxExecutions of them.
+		if tgh.maxExecutions > 0 && len(tasks) > tgh.maxExecutions {
+			tasks = tasks[len(tasks)-tgh.maxExecutions:]
+		}
+
 		if err = taskModel.BuildPreviousExecutions(tasks, tgh.sc.GetURL()); err != nil {
 			return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "API model error"))
 		}
@@ -87,9 +112,27 @@ func (tgh *taskGetHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "error retrieving artifacts"))
 	}
 
+	if tgh.artifactName != "" {
+		taskModel.Artifacts = filterArtifactsByName(taskModel.Artifacts, tgh.artifactName)
+	}
+
 	return gimlet.NewJSONResponse(taskModel)
 }
 
+// filterArtifactsByName returns the subset of files whose name either matches
+// the given glob pattern or contains pattern as a substring.
+func filterArtifactsByName(files []model.APIFile, pattern string) []model.APIFile {
+	filtered := []model.APIFile{}
+	for _, file := range files {
+		name := model.FromStringPtr(file.Name)
+		matched, err := filepath.Match(pattern, name)
+		if (err == nil && matched) || strings.Contains(name, pattern) {
+			filtered = append(filtered, file)
+		}
+	}
+	return filtered
+}
+
 ////////////////////////////////////////////////////////////////////////
 //
 // Handler for the tasks for a project
@@ -100,6 +143,8 @@ type projectTaskGetHandler struct {
 	finishedBefore time.Time
 	projectId      string
 	statuses       []string
+	limit          int
+	startAt        string
 	sc             data.Connector
 }
 
@@ -155,6 +200,20 @@ func (h *projectTaskGetHandler) Parse(ctx context.Context, r *http.Request) erro
 		h.statuses = statuses
 	}
 
+	// Parse limit
+	if limit := vals.Get("limit"); limit != "" {
+		h.limit, err = strconv.Atoi(limit)
+		if err != nil {
+			return gimlet.ErrorResponse{
+				Message:    fmt.Sprintf("problem parsing limit from '%s' (%s)", limit, err.Error()),
+				StatusCode: http.StatusBadRequest,
+			}
+		}
+	}
+
+	// Parse start_at, a task id cursor for paging
+	h.startAt = vals.Get("start_at")
+
 	return nil
 }
 
@@ -164,7 +223,7 @@ func (h *projectTaskGetHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONErrorResponder(err)
 	}
 
-	tasks, err := h.sc.FindTaskWithinTimePeriod(h.startedAfter, h.finishedBefore, h.projectId, h.statuses)
+	tasks, err := h.sc.FindTaskWithinTimePeriod(h.startedAfter, h.finishedBefore, h.projectId, h.statuses, h.startAt, h.limit)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "Database error"))
 	}
@@ -302,6 +361,7 @@ func (tep *taskExecutionPatchHandler) Run(ctx context.Context) gimlet.Responder
 
 type displayTaskGetHandler struct {
 	taskID string
+	full   bool
 	sc     data.Connector
 }
 
@@ -321,6 +381,7 @@ func (rh *displayTaskGetHandler) Parse(ctx context.Context, r *http.Request) err
 	if rh.taskID = gimlet.GetVars(r)["task_id"]; rh.taskID == "" {
 		return errors.New("missing task ID")
 	}
+	rh.full = r.URL.Query().Get("full") == "true"
 	return nil
 }
 
@@ -338,9 +399,23 @@ func (rh *displayTaskGetHandler) Run(ctx context.Context) gimlet.Responder {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "finding display task for task %s", rh.taskID))
 	}
 	if dt == nil {
+		if rh.full {
+			return gimlet.NewJSONResponse(nil)
+		}
 		return gimlet.NewTextResponse("")
 	}
 
+	if rh.full {
+		taskModel := &model.APITask{}
+		if err = taskModel.BuildFromService(dt); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting display task to API model"))
+		}
+		if err = taskModel.BuildFromService(rh.sc.GetURL()); err != nil {
+			return gimlet.MakeJSONInternalErrorResponder(errors.Wrap(err, "converting display task to API model"))
+		}
+		return gimlet.NewJSONResponse(taskModel)
+	}
+
 	return gimlet.NewTextResponse(dt.DisplayName)
 }
 
@@ -376,6 +451,14 @@ func (rh *taskSyncPathGetHandler) Run(ctx context.Context) gimlet.Responder {
 	if err != nil {
 		return gimlet.MakeJSONInternalErrorResponder(errors.Wrapf(err, "could not find task with ID '%s'", rh.taskID))
 	}
+
+	if !t.CanSync || !t.IsFinished() {
+		return gimlet.MakeJSONErrorResponder(gimlet.ErrorResponse{
+			StatusCode: http.StatusConflict,
+			Message:    fmt.Sprintf("task '%s' has not run task sync", rh.taskID),
+		})
+	}
+
 	return gimlet.NewTextResponse(t.S3Path(t.BuildVariant, t.DisplayName))
 }
 
@@ -419,6 +502,7 @@ func (rh *taskSetHasCedarResultsHandler) Run(ctx context.Context) gimlet.Respond
 
 type taskSyncReadCredentialsGetHandler struct {
 	taskID string
+	scoped bool
 	sc     data.Connector
 }
 
@@ -435,13 +519,30 @@ func (rh *taskSyncReadCredentialsGetHandler) Factory() gimlet.RouteHandler {
 }
 
 func (rh *taskSyncReadCredentialsGetHandler) Parse(ctx context.Context, r *http.Request) error {
+	rh.taskID = r.URL.Query().Get("task_id")
+	rh.scoped = r.URL.Query().Get("scoped") == "true"
+	if rh.scoped && rh.taskID == "" {
+		return errors.New("must specify 'task_id' to request scoped credentials")
+	}
 	return nil
 }
 
 func (rh *taskSyncReadCredentialsGetHandler) Run(ctx context.Context) gimlet.Responder {
-	settings, err := rh.sc.GetEvergreenSettings()
+	if !rh.scoped {
+		settings, err := rh.sc.GetEvergreenSettings()
+		if err != nil {
+			return gimlet.MakeJSONErrorResponder(err)
+		}
+		return gimlet.NewJSONResponse(settings.Providers.AWS.TaskSyncRead)
+	}
+
+	t, err := rh.sc.FindTaskById(rh.taskID)
 	if err != nil {
-		return gimlet.MakeJSONErrorResponder(err)
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "error finding task"))
+	}
+	creds, err := rh.sc.CreateTaskSyncReadCredentials(t)
+	if err != nil {
+		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "error creating scoped task sync read credentials"))
 	}
-	return gimlet.NewJSONResponse(settings.Providers.AWS.TaskSyncRead)
+	return gimlet.NewJSONResponse(*creds)
 }
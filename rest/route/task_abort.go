@@ -1,17 +1,31 @@
 package route
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"io/ioutil"
 	"net/http"
 
+	"github.com/evergreen-ci/evergreen"
+	"github.com/evergreen-ci/evergreen/model"
+	"github.com/evergreen-ci/evergreen/model/task"
 	"github.com/evergreen-ci/evergreen/rest/data"
-	"github.com/evergreen-ci/evergreen/rest/model"
+	restModel "github.com/evergreen-ci/evergreen/rest/model"
 	"github.com/evergreen-ci/gimlet"
+	"github.com/evergreen-ci/utility"
 	"github.com/pkg/errors"
 )
 
+// taskAbortOptions is the optional request body for aborting a task,
+// allowing the caller to record why the task was aborted.
+type taskAbortOptions struct {
+	Reason string `json:"reason"`
+}
+
 type taskAbortHandler struct {
 	taskId string
+	reason string
 	sc     data.Connector
 }
 
@@ -29,11 +43,27 @@ func (t *taskAbortHandler) Factory() gimlet.RouteHandler {
 
 func (t *taskAbortHandler) Parse(ctx context.Context, r *http.Request) error {
 	t.taskId = gimlet.GetVars(r)["task_id"]
+
+	defer r.Body.Close()
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return errors.Wrap(err, "reading request body")
+	}
+	if len(body) == 0 {
+		return nil
+	}
+
+	opts := taskAbortOptions{}
+	if err = json.Unmarshal(body, &opts); err != nil {
+		return errors.Wrap(err, "parsing request body")
+	}
+	t.reason = opts.Reason
+
 	return nil
 }
 
 func (t *taskAbortHandler) Run(ctx context.Context) gimlet.Responder {
-	err := t.sc.AbortTask(t.taskId, MustHaveUser(ctx).Id)
+	err := t.sc.AbortTask(t.taskId, MustHaveUser(ctx).Id, t.reason)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "Abort error"))
 	}
@@ -42,7 +72,7 @@ func (t *taskAbortHandler) Run(ctx context.Context) gimlet.Responder {
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "Database error"))
 	}
-	taskModel := &model.APITask{}
+	taskModel := &restModel.APITask{}
 
 	if err = taskModel.BuildFromService(foundTask); err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "API model error"))
@@ -50,3 +80,135 @@ func (t *taskAbortHandler) Run(ctx context.Context) gimlet.Responder {
 
 	return gimlet.NewJSONResponse(taskModel)
 }
+
+// tasksAbortOptions is the request body for aborting a batch of tasks, either
+// by specifying the task IDs directly or the ID of the version they belong
+// to.
+type tasksAbortOptions struct {
+	TaskIDs   []string `json:"task_ids"`
+	VersionID string   `json:"version_id"`
+	Reason    string   `json:"reason"`
+}
+
+// taskAbortResult records the outcome of aborting a single task as part of a
+// batch abort request.
+type taskAbortResult struct {
+	TaskID string `json:"task_id"`
+	Error  string `json:"error,omitempty"`
+}
+
+// tasksAbortHandler aborts a batch of tasks, either given explicitly by task
+// ID or all tasks belonging to a version, and reports per-task success or
+// failure.
+type tasksAbortHandler struct {
+	taskIds   []string
+	versionId string
+	reason    string
+	sc        data.Connector
+}
+
+func makeTasksAbortHandler(sc data.Connector) gimlet.RouteHandler {
+	return &tasksAbortHandler{
+		sc: sc,
+	}
+}
+
+func (h *tasksAbortHandler) Factory() gimlet.RouteHandler {
+	return &tasksAbortHandler{
+		sc: h.sc,
+	}
+}
+
+func (h *tasksAbortHandler) Parse(ctx context.Context, r *http.Request) error {
+	opts := tasksAbortOptions{}
+	if err := utility.ReadJSON(r.Body, &opts); err != nil {
+		return errors.Wrap(err, "parsing request body")
+	}
+	if len(opts.TaskIDs) == 0 && opts.VersionID == "" {
+		return errors.New("must specify either 'task_ids' or 'version_id'")
+	}
+
+	h.taskIds = opts.TaskIDs
+	h.versionId = opts.VersionID
+	h.reason = opts.Reason
+
+	return nil
+}
+
+func (h *tasksAbortHandler) Run(ctx context.Context) gimlet.Responder {
+	taskIds := h.taskIds
+	if h.versionId != "" {
+		versionTasks, _, err := h.sc.FindTasksByVersion(h.versionId, "", nil, "", "", 1, 0, 0, []string{task.IdKey})
+		if err != nil {
+			return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "error finding tasks for version"))
+		}
+		for _, t := range versionTasks {
+			taskIds = append(taskIds, t.Id)
+		}
+	}
+
+	userId := MustHaveUser(ctx).Id
+	results := make([]taskAbortResult, 0, len(taskIds))
+	for _, taskId := range taskIds {
+		result := taskAbortResult{TaskID: taskId}
+		if err := h.sc.AbortTask(taskId, userId, h.reason); err != nil {
+			result.Error = err.Error()
+		}
+		results = append(results, result)
+	}
+
+	return gimlet.NewJSONResponse(results)
+}
+
+// RequiresTasksAbortPermission checks that the user has permission to edit
+// tasks for the project that owns the task_ids/version_id given in the
+// /tasks/abort request body. Unlike most routes, that project can't be
+// determined from URL vars or query params, since task_ids and version_id
+// only ever appear in the JSON body, so this reads and restores the body
+// itself instead of relying on urlVarsToProjectScopes.
+func RequiresTasksAbortPermission() gimlet.Middleware {
+	return requiresProjectPermissionWithResourceFunc(evergreen.PermissionTasks, evergreen.TasksBasic, tasksAbortResourceFunc)
+}
+
+func tasksAbortResourceFunc(r *http.Request) ([]string, int, error) {
+	body, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		return nil, http.StatusBadRequest, errors.Wrap(err, "reading request body")
+	}
+	r.Body.Close()
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	opts := tasksAbortOptions{}
+	if err = json.Unmarshal(body, &opts); err != nil {
+		return nil, http.StatusBadRequest, errors.Wrap(err, "parsing request body")
+	}
+
+	var projectID string
+	switch {
+	case opts.VersionID != "":
+		projectID, err = model.FindProjectForVersion(opts.VersionID)
+	case len(opts.TaskIDs) > 0:
+		projectID, err = task.FindProjectForTask(opts.TaskIDs[0])
+	default:
+		return nil, http.StatusBadRequest, errors.New("must specify either 'task_ids' or 'version_id'")
+	}
+	if err != nil {
+		return nil, http.StatusNotFound, err
+	}
+	if projectID == "" {
+		return nil, http.StatusNotFound, errors.New("no project found")
+	}
+
+	projectIDs := []string{projectID}
+	for _, taskID := range opts.TaskIDs {
+		taskProjectID, err := task.FindProjectForTask(taskID)
+		if err != nil {
+			return nil, http.StatusNotFound, err
+		}
+		if taskProjectID != "" && !utility.StringSliceContains(projectIDs, taskProjectID) {
+			projectIDs = append(projectIDs, taskProjectID)
+		}
+	}
+
+	return projectIDs, http.StatusOK, nil
+}
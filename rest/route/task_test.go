@@ -41,7 +41,8 @@ func (s *TaskAbortSuite) SetupSuite() {
 			{Id: "task1"},
 			{Id: "task2"},
 		},
-		CachedAborted: make(map[string]string),
+		CachedAborted:        make(map[string]string),
+		CachedAbortedReasons: make(map[string]string),
 	}
 	s.sc = &data.MockConnector{
 		MockTaskConnector: s.data,
@@ -75,6 +76,26 @@ func (s *TaskAbortSuite) TestAbort() {
 	s.Equal(model.ToStringPtr("task1"), t.Id)
 }
 
+func (s *TaskAbortSuite) TestAbortWithReason() {
+	ctx := context.Background()
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user1"})
+
+	body := bytes.NewBufferString(`{"reason": "no longer needed"}`)
+	r, err := http.NewRequest(http.MethodPost, "/tasks/task1/abort", body)
+	s.NoError(err)
+
+	rm := makeTaskAbortHandler(s.sc)
+	handler := rm.(*taskAbortHandler)
+	handler.taskId = "task1"
+	s.NoError(handler.Parse(ctx, r))
+	s.Equal("no longer needed", handler.reason)
+
+	res := handler.Run(ctx)
+	s.Equal(http.StatusOK, res.Status())
+	s.Equal("user1", s.data.CachedAborted["task1"])
+	s.Equal("no longer needed", s.data.CachedAbortedReasons["task1"])
+}
+
 func (s *TaskAbortSuite) TestAbortFail() {
 	ctx := context.Background()
 	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user1"})
@@ -86,6 +107,81 @@ func (s *TaskAbortSuite) TestAbortFail() {
 	s.Equal(http.StatusBadRequest, resp.Status())
 }
 
+func TestTasksAbortHandler(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ctx := context.Background()
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user1"})
+
+	sc := &data.MockConnector{MockTaskConnector: data.MockTaskConnector{
+		CachedAborted:        make(map[string]string),
+		CachedAbortedReasons: make(map[string]string),
+	}}
+
+	body := bytes.NewBufferString(`{"task_ids": ["task1", "task2"], "reason": "restarting build"}`)
+	r, err := http.NewRequest(http.MethodPost, "/tasks/abort", body)
+	require.NoError(err)
+
+	rm := makeTasksAbortHandler(sc)
+	handler := rm.(*tasksAbortHandler)
+	require.NoError(handler.Parse(ctx, r))
+	assert.Equal([]string{"task1", "task2"}, handler.taskIds)
+	assert.Equal("restarting build", handler.reason)
+
+	resp := handler.Run(ctx)
+	assert.Equal(http.StatusOK, resp.Status())
+	results, ok := resp.Data().([]taskAbortResult)
+	require.True(ok)
+	require.Len(results, 2)
+	for _, result := range results {
+		assert.Empty(result.Error)
+	}
+	assert.Equal("user1", sc.MockTaskConnector.CachedAborted["task1"])
+	assert.Equal("restarting build", sc.MockTaskConnector.CachedAbortedReasons["task1"])
+	assert.Equal("user1", sc.MockTaskConnector.CachedAborted["task2"])
+}
+
+func TestTasksAbortHandlerAggregatesErrors(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	ctx := context.Background()
+	ctx = gimlet.AttachUser(ctx, &user.DBUser{Id: "user1"})
+
+	sc := &data.MockConnector{MockTaskConnector: data.MockTaskConnector{
+		CachedAborted: make(map[string]string),
+		FailOnAbort:   true,
+	}}
+
+	body := bytes.NewBufferString(`{"task_ids": ["task1", "task2"]}`)
+	r, err := http.NewRequest(http.MethodPost, "/tasks/abort", body)
+	require.NoError(err)
+
+	rm := makeTasksAbortHandler(sc)
+	handler := rm.(*tasksAbortHandler)
+	require.NoError(handler.Parse(ctx, r))
+
+	resp := handler.Run(ctx)
+	assert.Equal(http.StatusOK, resp.Status())
+	results, ok := resp.Data().([]taskAbortResult)
+	require.True(ok)
+	require.Len(results, 2)
+	for _, result := range results {
+		assert.NotEmpty(result.Error)
+	}
+}
+
+func TestTasksAbortHandlerRequiresTaskIdsOrVersionId(t *testing.T) {
+	ctx := context.Background()
+	sc := &data.MockConnector{}
+	r, err := http.NewRequest(http.MethodPost, "/tasks/abort", bytes.NewBufferString(`{}`))
+	require.NoError(t, err)
+
+	rm := makeTasksAbortHandler(sc)
+	assert.Error(t, rm.Parse(ctx, r))
+}
+
 func TestFetchArtifacts(t *testing.T) {
 	assert := assert.New(t)
 	require := require.New(t)
@@ -133,6 +229,16 @@ func TestFetchArtifacts(t *testing.T) {
 	assert.Len(apiTask.Artifacts, 2)
 	assert.Empty(apiTask.PreviousExecutions)
 
+	// filter artifacts by name
+	taskGet.artifactName = "file1"
+	resp = taskGet.Run(context.Background())
+	require.NotNil(resp)
+	assert.Equal(resp.Status(), http.StatusOK)
+	apiTask = resp.Data().(*model.APITask)
+	require.Len(apiTask.Artifacts, 1)
+	assert.Equal("file1", model.FromStringPtr(apiTask.Artifacts[0].Name))
+	taskGet.artifactName = ""
+
 	// fetch all
 	taskGet.fetchAllExecutions = true
 	resp = taskGet.Run(context.Background())
@@ -162,6 +268,100 @@ func TestFetchArtifacts(t *testing.T) {
 	assert.NotZero(apiTask.PreviousExecutions[0])
 }
 
+func TestTaskGetHandlerParseMaxExecutions(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	tgh := &taskGetHandler{}
+	r, err := http.NewRequest(http.MethodGet, "/tasks/task1?max_executions=3", nil)
+	require.NoError(err)
+	require.NoError(tgh.Parse(context.Background(), r))
+	assert.Equal(3, tgh.maxExecutions)
+
+	tgh = &taskGetHandler{}
+	r, err = http.NewRequest(http.MethodGet, "/tasks/task1", nil)
+	require.NoError(err)
+	require.NoError(tgh.Parse(context.Background(), r))
+	assert.Zero(tgh.maxExecutions)
+
+	tgh = &taskGetHandler{}
+	r, err = http.NewRequest(http.MethodGet, "/tasks/task1?max_executions=not_a_number", nil)
+	require.NoError(err)
+	assert.Error(tgh.Parse(context.Background(), r))
+
+	tgh = &taskGetHandler{}
+	r, err = http.NewRequest(http.MethodGet, "/tasks/task1?max_executions=0", nil)
+	require.NoError(err)
+	assert.Error(tgh.Parse(context.Background(), r))
+}
+
+func TestTaskGetHandlerParseArtifactName(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	tgh := &taskGetHandler{}
+	r, err := http.NewRequest(http.MethodGet, "/tasks/task1?artifact_name=core*", nil)
+	require.NoError(err)
+	require.NoError(tgh.Parse(context.Background(), r))
+	assert.Equal("core*", tgh.artifactName)
+
+	tgh = &taskGetHandler{}
+	r, err = http.NewRequest(http.MethodGet, "/tasks/task1", nil)
+	require.NoError(err)
+	require.NoError(tgh.Parse(context.Background(), r))
+	assert.Empty(tgh.artifactName)
+}
+
+func TestFilterArtifactsByName(t *testing.T) {
+	files := []model.APIFile{
+		{Name: model.ToStringPtr("core.dump")},
+		{Name: model.ToStringPtr("test_output.log")},
+		{Name: model.ToStringPtr("core.dump.2")},
+	}
+
+	filtered := filterArtifactsByName(files, "core*")
+	assert.Len(t, filtered, 2)
+
+	filtered = filterArtifactsByName(files, "test_output.log")
+	require.Len(t, filtered, 1)
+	assert.Equal(t, "test_output.log", model.FromStringPtr(filtered[0].Name))
+
+	filtered = filterArtifactsByName(files, "nonexistent")
+	assert.Empty(t, filtered)
+}
+
+func TestTaskGetHandlerFetchAllExecutionsWithMax(t *testing.T) {
+	assert := assert.New(t)
+	require := require.New(t)
+
+	oldTasks := []task.Task{
+		{Id: "task1", Execution: 0},
+		{Id: "task1", Execution: 1},
+		{Id: "task1", Execution: 2},
+	}
+
+	sc := &data.MockConnector{MockTaskConnector: data.MockTaskConnector{
+		CachedTasks:    []task.Task{{Id: "task1", Execution: 3}},
+		CachedOldTasks: oldTasks,
+	}}
+
+	taskGet := taskGetHandler{taskID: "task1", fetchAllExecutions: true, sc: sc}
+	resp := taskGet.Run(context.Background())
+	require.NotNil(resp)
+	assert.Equal(http.StatusOK, resp.Status())
+	apiTask := resp.Data().(*model.APITask)
+	require.Len(apiTask.PreviousExecutions, 3)
+
+	taskGet.maxExecutions = 2
+	resp = taskGet.Run(context.Background())
+	require.NotNil(resp)
+	assert.Equal(http.StatusOK, resp.Status())
+	apiTask = resp.Data().(*model.APITask)
+	require.Len(apiTask.PreviousExecutions, 2)
+	assert.Equal(1, apiTask.PreviousExecutions[0].Execution)
+	assert.Equal(2, apiTask.PreviousExecutions[1].Execution)
+}
+
 type ProjectTaskWithinDatesSuite struct {
 	sc *data.MockConnector
 	h  *projectTaskGetHandler
@@ -182,7 +382,9 @@ func (s *ProjectTaskWithinDatesSuite) TestParseAllArguments() {
 		"?status=A" +
 		"&status=B" +
 		"&started_after=2018-01-01T00%3A00%3A00Z" +
-		"&finished_before=2018-02-02T00%3A00%3A00Z"
+		"&finished_before=2018-02-02T00%3A00%3A00Z" +
+		"&limit=50" +
+		"&start_at=task_123"
 	r, err := http.NewRequest("GET", url, &bytes.Buffer{})
 	s.Require().NoError(err)
 	err = s.h.Parse(context.Background(), r)
@@ -190,6 +392,8 @@ func (s *ProjectTaskWithinDatesSuite) TestParseAllArguments() {
 	s.Subset([]string{"A", "B"}, s.h.statuses)
 	s.Equal(s.h.startedAfter, time.Date(2018, time.January, 1, 0, 0, 0, 0, time.UTC))
 	s.Equal(s.h.finishedBefore, time.Date(2018, time.February, 2, 0, 0, 0, 0, time.UTC))
+	s.Equal(50, s.h.limit)
+	s.Equal("task_123", s.h.startAt)
 }
 
 func (s *ProjectTaskWithinDatesSuite) TestHasDefaultValues() {
@@ -200,6 +404,16 @@ func (s *ProjectTaskWithinDatesSuite) TestHasDefaultValues() {
 	s.Equal([]string(nil), s.h.statuses)
 	s.True(s.h.startedAfter.Unix()-time.Now().AddDate(0, 0, -7).Unix() <= 0)
 	s.Equal(time.Time{}, s.h.finishedBefore)
+	s.Equal(0, s.h.limit)
+	s.Equal("", s.h.startAt)
+}
+
+func (s *ProjectTaskWithinDatesSuite) TestParseInvalidLimit() {
+	url := "https://evergreen.mongodb.com/rest/v2/projects/none/versions/tasks?limit=not_a_number"
+	r, err := http.NewRequest("GET", url, &bytes.Buffer{})
+	s.Require().NoError(err)
+	err = s.h.Parse(context.Background(), r)
+	s.Error(err)
 }
 
 func TestGetDisplayTaskName(t *testing.T) {
@@ -252,6 +466,35 @@ func TestGetDisplayTaskName(t *testing.T) {
 			require.NotNil(t, resp)
 			assert.Equal(t, http.StatusOK, resp.Status())
 		},
+		"FullReturnsAPITaskOfDisplayTask": func(ctx context.Context, t *testing.T) {
+			tsk := task.Task{Id: "task_id"}
+			displayTask := task.Task{
+				Id:             "display_task_id",
+				DisplayName:    "display_task_name",
+				DisplayOnly:    true,
+				ExecutionTasks: []string{tsk.Id},
+			}
+			require.NoError(t, displayTask.Insert())
+
+			h := makeGetDisplayTaskHandler(&data.MockConnector{
+				MockTaskConnector: data.MockTaskConnector{
+					CachedTasks: []task.Task{tsk},
+				},
+			})
+			rh, ok := h.(*displayTaskGetHandler)
+			require.True(t, ok)
+			rh.taskID = tsk.Id
+			rh.full = true
+
+			resp := rh.Run(ctx)
+			require.NotNil(t, resp)
+			apiTask, ok := resp.Data().(*model.APITask)
+			require.True(t, ok)
+			assert.Equal(t, displayTask.Id, model.FromStringPtr(apiTask.Id))
+			assert.Equal(t, displayTask.DisplayName, model.FromStringPtr(apiTask.DisplayName))
+			require.Len(t, apiTask.ExecutionTasks, 1)
+			assert.Equal(t, tsk.Id, model.FromStringPtr(apiTask.ExecutionTasks[0]))
+		},
 	} {
 		t.Run(testName, func(t *testing.T) {
 			ctx, cancel := context.WithCancel(context.Background())
@@ -294,6 +537,47 @@ func TestGetTaskSyncReadCredentials(t *testing.T) {
 	assert.Equal(t, creds, respCreds)
 }
 
+func TestGetTaskSyncReadCredentialsScoped(t *testing.T) {
+	scopedCreds := evergreen.S3Credentials{
+		Key:    "scoped_key",
+		Secret: "scoped_secret",
+		Bucket: "bucket",
+		Token:  "scoped_token",
+	}
+	sc := &data.MockConnector{
+		MockTaskConnector: data.MockTaskConnector{
+			CachedTasks: []task.Task{{Id: "task_id"}},
+		},
+		MockAdminConnector: data.MockAdminConnector{
+			MockSettings:            &evergreen.Settings{},
+			ScopedTaskSyncReadCreds: &scopedCreds,
+		},
+	}
+	rh := makeTaskSyncReadCredentialsGetHandler(sc)
+
+	r, err := http.NewRequest(http.MethodGet, "/task/sync_read_credentials?task_id=task_id&scoped=true", nil)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, rh.Parse(ctx, r))
+
+	resp := rh.Run(ctx)
+	require.NotNil(t, resp)
+	respCreds, ok := resp.Data().(evergreen.S3Credentials)
+	require.True(t, ok)
+	assert.Equal(t, scopedCreds, respCreds)
+}
+
+func TestGetTaskSyncReadCredentialsScopedRequiresTaskID(t *testing.T) {
+	rh := makeTaskSyncReadCredentialsGetHandler(&data.MockConnector{})
+
+	r, err := http.NewRequest(http.MethodGet, "/task/sync_read_credentials?scoped=true", nil)
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	assert.Error(t, rh.Parse(ctx, r))
+}
+
 func TestGetTaskSyncPath(t *testing.T) {
 	expected := task.Task{
 		Id:           "task_id",
@@ -301,6 +585,8 @@ func TestGetTaskSyncPath(t *testing.T) {
 		Version:      "version",
 		BuildVariant: "build_variant",
 		DisplayName:  "name",
+		Status:       evergreen.TaskSucceeded,
+		CanSync:      true,
 	}
 	h := makeTaskSyncPathGetHandler(&data.MockConnector{
 		MockTaskConnector: data.MockTaskConnector{
@@ -320,3 +606,28 @@ func TestGetTaskSyncPath(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, path, expected.S3Path(expected.BuildVariant, expected.DisplayName))
 }
+
+func TestGetTaskSyncPathNeverSynced(t *testing.T) {
+	neverSynced := task.Task{
+		Id:           "task_id",
+		BuildVariant: "build_variant",
+		DisplayName:  "name",
+		Status:       evergreen.TaskUndispatched,
+		CanSync:      false,
+	}
+	h := makeTaskSyncPathGetHandler(&data.MockConnector{
+		MockTaskConnector: data.MockTaskConnector{
+			CachedTasks: []task.Task{neverSynced},
+		},
+	})
+	rh, ok := h.(*taskSyncPathGetHandler)
+	require.True(t, ok)
+	rh.taskID = neverSynced.Id
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	resp := rh.Run(ctx)
+
+	require.NotNil(t, resp)
+	assert.Equal(t, http.StatusConflict, resp.Status())
+}
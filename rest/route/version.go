@@ -183,8 +183,9 @@ func (h *versionAbortHandler) Run(ctx context.Context) gimlet.Responder {
 // versionRestartHandler is a RequestHandler for restarting all completed tasks
 // of a version.
 type versionRestartHandler struct {
-	versionId string
-	sc        data.Connector
+	versionId  string
+	failedOnly bool
+	sc         data.Connector
 }
 
 func makeRestartVersion(sc data.Connector) gimlet.RouteHandler {
@@ -206,13 +207,15 @@ func (h *versionRestartHandler) Parse(ctx context.Context, r *http.Request) erro
 		return errors.New("request data incomplete")
 	}
 
+	h.failedOnly = r.URL.Query().Get("failed_only") == "true"
+
 	return nil
 }
 
 // Execute calls the data RestartVersion function to restart completed tasks of a version.
 func (h *versionRestartHandler) Run(ctx context.Context) gimlet.Responder {
 	// Restart the version
-	err := h.sc.RestartVersion(h.versionId, MustHaveUser(ctx).Id)
+	err := h.sc.RestartVersion(h.versionId, MustHaveUser(ctx).Id, h.failedOnly)
 	if err != nil {
 		return gimlet.MakeJSONErrorResponder(errors.Wrap(err, "Database error in restarting version"))
 	}
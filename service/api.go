@@ -482,6 +482,11 @@ func (as *APIServer) validateProjectConfig(w http.ResponseWriter, r *http.Reques
 	errs := validator.CheckYamlStrict(yamlBytes)
 	errs = append(errs, validator.CheckProjectSyntax(project)...)
 	errs = append(errs, validator.CheckProjectSemantics(project)...)
+
+	if r.FormValue("include_warnings") == "false" {
+		errs = errs.AtLevel(validator.Error)
+	}
+
 	if len(errs) > 0 {
 		gimlet.WriteJSONError(w, errs)
 		return
@@ -575,7 +575,11 @@ func (ra *restAPI) lastGreen(w http.ResponseWriter, r *http.Request) {
 
 	// queryParams should list build variants, example:
 	// GET /rest/v1/projects/mongodb-mongo-master/last_green?linux-64=1&windows-64=1
+	// requireAll defaults to true; pass require_all=false to instead accept
+	// a version where any one of the listed variants passed.
 	queryParams := r.URL.Query()
+	requireAll := queryParams.Get("require_all") != "false"
+	queryParams.Del("require_all")
 
 	// Make sure all query params are valid variants and put them in an array
 	var bvs []string
@@ -589,8 +593,8 @@ func (ra *restAPI) lastGreen(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	// Get latest version for which all the given build variants passed.
-	version, err := model.FindLastPassingVersionForBuildVariants(project, bvs)
+	// Get latest version for which the given build variants passed.
+	version, err := model.FindLastPassingVersionForBuildVariants(project, bvs, requireAll)
 	if err != nil {
 		ra.LoggedError(w, r, http.StatusInternalServerError, err)
 		return
@@ -81,6 +81,91 @@ func GitApplyNumstat(patch string) (*bytes.Buffer, error) {
 	return &summaryBuffer, nil
 }
 
+// GitApplyCheck does a dry run apply of patch against the working directory
+// dir, without writing any changes. It returns the names of any files that
+// fail to apply cleanly, which is empty if the patch applies without
+// conflicts.
+func GitApplyCheck(dir, patch string) ([]string, error) {
+	handle, err := ioutil.TempFile("", utility.RandomString())
+	if err != nil {
+		return nil, errors.New("Unable to create local patch file")
+	}
+	defer func() {
+		grip.Error(handle.Close())
+		grip.Error(os.Remove(handle.Name()))
+	}()
+	if _, err = handle.WriteString(patch); err != nil {
+		return nil, errors.New("Unable to write supplied patch file")
+	}
+
+	var output bytes.Buffer
+	cmd := exec.Command("git", "apply", "--check", handle.Name())
+	cmd.Dir = dir
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+
+	if err = cmd.Run(); err != nil {
+		return parseGitApplyConflicts(output.String()), nil
+	}
+
+	return nil, nil
+}
+
+// GitApply applies patch to the working directory dir, creating and
+// modifying files as needed.
+func GitApply(dir, patch string) error {
+	handle, err := ioutil.TempFile("", utility.RandomString())
+	if err != nil {
+		return errors.New("Unable to create local patch file")
+	}
+	defer func() {
+		grip.Error(handle.Close())
+		grip.Error(os.Remove(handle.Name()))
+	}()
+	if _, err = handle.WriteString(patch); err != nil {
+		return errors.New("Unable to write supplied patch file")
+	}
+
+	var output bytes.Buffer
+	cmd := exec.Command("git", "apply", handle.Name())
+	cmd.Dir = dir
+	cmd.Stdout = &output
+	cmd.Stderr = &output
+	if err = cmd.Run(); err != nil {
+		return errors.Wrapf(err, "error applying patch: %s", output.String())
+	}
+
+	return nil
+}
+
+// parseGitApplyConflicts extracts the file names referenced in git apply's
+// failure output, e.g. "error: patch failed: some/file.go:12" or
+// "error: some/file.go: patch does not apply".
+func parseGitApplyConflicts(output string) []string {
+	var files []string
+	seen := map[string]bool{}
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.HasPrefix(line, "error: ") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "error: ")
+		line = strings.TrimSuffix(line, ": patch does not apply")
+		if idx := strings.Index(line, "patch failed: "); idx >= 0 {
+			line = line[idx+len("patch failed: "):]
+		}
+		if colon := strings.LastIndex(line, ":"); colon >= 0 {
+			if _, err := strconv.Atoi(line[colon+1:]); err == nil {
+				line = line[:colon]
+			}
+		}
+		if line != "" && !seen[line] {
+			seen[line] = true
+			files = append(files, line)
+		}
+	}
+	return files
+}
+
 // ParseGitSummary takes in a buffer of data and parses it into a slice of
 // git summaries. It returns an error if it is unable to parse the data
 func ParseGitSummary(gitOutput fmt.Stringer) (summaries []Summary, err error) {
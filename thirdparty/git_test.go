@@ -2,6 +2,10 @@ package thirdparty
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -129,6 +133,39 @@ func TestParseGitUrl(t *testing.T) {
 	assert.Equal("", repo)
 }
 
+func TestGitApplyCheckAndGitApply(t *testing.T) {
+	dir, err := ioutil.TempDir("", "git-apply-check-test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	require.NoError(t, exec.Command("git", "init", dir).Run())
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "test.txt"), []byte("hello\n"), 0644))
+
+	patch := `diff --git a/test.txt b/test.txt
+index ce01362..94954ab 100644
+--- a/test.txt
++++ b/test.txt
+@@ -1 +1 @@
+-hello
++goodbye
+`
+
+	conflicts, err := GitApplyCheck(dir, patch)
+	assert.NoError(t, err)
+	assert.Empty(t, conflicts)
+
+	require.NoError(t, GitApply(dir, patch))
+	contents, err := ioutil.ReadFile(filepath.Join(dir, "test.txt"))
+	require.NoError(t, err)
+	assert.Equal(t, "goodbye\n", string(contents))
+
+	// Applying the same patch again should now conflict, since the file no
+	// longer matches the patch's expected starting contents.
+	conflicts, err = GitApplyCheck(dir, patch)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"test.txt"}, conflicts)
+}
+
 func TestGetPatchSummariesByCommit(t *testing.T) {
 	summaries, commitMessages, err := GetPatchSummariesFromMboxPatch(mboxPatch)
 	assert.NoError(t, err)
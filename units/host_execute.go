@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/evergreen-ci/evergreen"
 	"github.com/evergreen-ci/evergreen/model/event"
@@ -20,6 +21,11 @@ import (
 
 const (
 	hostExecuteJobName = "host-execute"
+
+	// hostExecuteScriptTimeout hard-caps how long a user-submitted script may
+	// run on the host, independent of ctx cancellation, so a hung script
+	// can't pin a host indefinitely.
+	hostExecuteScriptTimeout = 10 * time.Minute
 )
 
 func init() {
@@ -93,9 +99,9 @@ func (j *hostExecuteJob) Run(ctx context.Context) {
 		}
 		args = append(args, j.host.Distro.ShellBinary(), "-l", "-c", j.Script)
 		var output []string
-		output, err := j.host.RunJasperProcess(ctx, j.env, &options.Create{
+		output, err := j.host.RunJasperProcessWithTimeout(ctx, j.env, &options.Create{
 			Args: args,
-		})
+		}, hostExecuteScriptTimeout)
 		if err != nil {
 			event.LogHostScriptExecuteFailed(j.host.Id, err)
 			grip.Error(message.WrapError(err, message.Fields{
@@ -3,6 +3,7 @@ package units
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"time"
 
@@ -21,6 +22,11 @@ import (
 
 const cloudHostReadyJobName = "set-cloud-hosts-ready"
 
+// instanceNotFoundIDsRegexp extracts the comma-separated instance IDs from an
+// AWS InvalidInstanceID.NotFound error message, tolerating surrounding text
+// and other quoted substrings in the message.
+var instanceNotFoundIDsRegexp = regexp.MustCompile(`[Ii]nstance [Ii][Dd]s?\s*'([^']*)'`)
+
 func init() {
 	registry.AddJobType(cloudHostReadyJobName,
 		func() amboy.Job { return makeCloudHostReadyJob() })
@@ -99,7 +105,16 @@ clientsLoop:
 			return
 		}
 		if batch, ok := m.(cloud.BatchManager); ok {
+			statusCallStart := time.Now()
 			statuses, err := batch.GetInstanceStatuses(ctx, hosts)
+			grip.Info(message.Fields{
+				"message":     "fetched cloud host statuses for region",
+				"provider":    clientOpts.Provider,
+				"region":      clientOpts.Region,
+				"num_hosts":   len(hosts),
+				"duration_ms": time.Since(statusCallStart).Milliseconds(),
+				"job":         j.ID(),
+			})
 			if err != nil {
 				if strings.Contains(err.Error(), "InvalidInstanceID.NotFound") {
 					j.AddError(j.terminateUnknownHosts(ctx, err.Error()))
@@ -121,6 +136,7 @@ clientsLoop:
 			}
 			continue clientsLoop
 		}
+		statusCallStart := time.Now()
 		for _, h := range hosts {
 			hostStatus, err := m.GetInstanceStatus(ctx, &h)
 			if err != nil {
@@ -129,30 +145,38 @@ clientsLoop:
 			}
 			j.AddError(errors.Wrap(j.setCloudHostStatus(ctx, m, h, hostStatus), "error settings instance statuses"))
 		}
+		grip.Info(message.Fields{
+			"message":     "fetched cloud host statuses for region",
+			"provider":    clientOpts.Provider,
+			"region":      clientOpts.Region,
+			"num_hosts":   len(hosts),
+			"duration_ms": time.Since(statusCallStart).Milliseconds(),
+			"job":         j.ID(),
+		})
 	}
 }
 
 func (j *cloudHostReadyJob) terminateUnknownHosts(ctx context.Context, awsErr string) error {
-	pieces := strings.Split(awsErr, "'")
-	if len(pieces) != 3 {
+	match := instanceNotFoundIDsRegexp.FindStringSubmatch(awsErr)
+	if len(match) != 2 {
 		return errors.Errorf("unexpected format of AWS error: %s", awsErr)
 	}
-	instanceIDs := strings.Split(pieces[1], ",")
+	instanceIDs := []string{}
+	for _, id := range strings.Split(match[1], ",") {
+		instanceIDs = append(instanceIDs, strings.TrimSpace(id))
+	}
 	grip.Warning(message.Fields{
 		"message": "host IDs not found in AWS, will terminate",
 		"hosts":   instanceIDs,
 	})
 	catcher := grip.NewBasicCatcher()
-	for _, hostID := range instanceIDs {
-		h, err := host.FindOneId(hostID)
-		if err != nil {
-			catcher.Add(err)
-			continue
-		}
-		if h == nil {
-			continue
-		}
-		catcher.Add(j.env.RemoteQueue().Put(ctx, NewHostTerminationJob(j.env, h, true, "instance ID not found")))
+	hosts, err := host.Find(host.ByIds(instanceIDs))
+	if err != nil {
+		catcher.Add(err)
+		return catcher.Resolve()
+	}
+	for i := range hosts {
+		catcher.Add(j.env.RemoteQueue().Put(ctx, NewHostTerminationJob(j.env, &hosts[i], true, "instance ID not found")))
 	}
 	return catcher.Resolve()
 }
@@ -173,6 +197,14 @@ func (j *cloudHostReadyJob) setCloudHostStatus(ctx context.Context, m cloud.Mana
 			return errors.Wrap(err, "problem doing initial setup")
 		}
 		return j.setNextState(h)
+	case cloud.StatusStopping, cloud.StatusStopped:
+		grip.Info(message.Fields{
+			"message":    "cloud provider reported host stopped before it finished starting",
+			"host_id":    h.Id,
+			"distro":     h.Distro.Id,
+			"hostStatus": hostStatus.String(),
+		})
+		return errors.Wrap(h.SetStopped(evergreen.User), "error marking host stopped")
 	}
 
 	grip.Info(message.Fields{
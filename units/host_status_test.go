@@ -71,6 +71,15 @@ func TestCloudStatusJob(t *testing.T) {
 				BootstrapSettings:    distro.BootstrapSettings{Method: distro.BootstrapMethodUserData},
 			},
 		},
+		{
+			Id:       "host-7",
+			Provider: evergreen.ProviderNameMock,
+			Status:   evergreen.HostStarting,
+			Distro: distro.Distro{
+				Provider:             evergreen.ProviderNameMock,
+				ProviderSettingsList: []*birch.Document{birch.NewDocument(birch.EC.String("region", "region-5"))},
+			},
+		},
 	}
 	mockState := cloud.GetMockProvider()
 	mockState.Reset()
@@ -78,13 +87,14 @@ func TestCloudStatusJob(t *testing.T) {
 		require.NoError(h.Insert())
 		mockState.Set(h.Id, cloud.MockInstance{DNSName: "dns_name"})
 	}
+	mockState.Set("host-7", cloud.MockInstance{DNSName: "dns_name", Status: cloud.StatusStopped})
 
 	j := NewCloudHostReadyJob(&mock.Environment{}, "id")
 	j.Run(context.Background())
 	assert.NoError(j.Error())
 
 	hosts, err := host.Find(db.Query(bson.M{}))
-	assert.Len(hosts, 6)
+	assert.Len(hosts, 7)
 	assert.NoError(err)
 	for _, h := range hosts {
 		if h.Id == "host-1" {
@@ -106,6 +116,9 @@ func TestCloudStatusJob(t *testing.T) {
 			assert.Equal(evergreen.HostStarting, h.Status)
 			assert.True(h.Provisioned)
 		}
+		if h.Id == "host-7" {
+			assert.Equal(evergreen.HostStopped, h.Status)
+		}
 	}
 }
 
@@ -119,10 +132,39 @@ func TestTerminateUnknownHosts(t *testing.T) {
 		Id: "h2",
 	}
 	require.NoError(t, h2.Insert())
+	h3 := host.Host{
+		Id: "h3",
+	}
+	require.NoError(t, h3.Insert())
 	env := &mock.Environment{}
 	ctx := context.Background()
 	require.NoError(t, env.Configure(ctx))
 	j := NewCloudHostReadyJob(env, "id").(*cloudHostReadyJob)
-	awsErr := "error getting host statuses for providers: error describing instances: after 10 retries, operation failed: InvalidInstanceID.NotFound: The instance IDs 'h1, h2' do not exist"
+	// "h4" doesn't exist in the database and should be skipped without
+	// causing an error.
+	awsErr := "error getting host statuses for providers: error describing instances: after 10 retries, operation failed: InvalidInstanceID.NotFound: The instance IDs 'h1, h2, h3, h4' do not exist"
 	assert.NoError(t, j.terminateUnknownHosts(ctx, awsErr))
 }
+
+func TestTerminateUnknownHostsAWSErrorFormats(t *testing.T) {
+	require.NoError(t, db.ClearCollections(host.Collection))
+	h1 := host.Host{Id: "i-0123456789abcdef0"}
+	require.NoError(t, h1.Insert())
+	h2 := host.Host{Id: "i-0fedcba9876543210"}
+	require.NoError(t, h2.Insert())
+
+	env := &mock.Environment{}
+	ctx := context.Background()
+	require.NoError(t, env.Configure(ctx))
+	j := NewCloudHostReadyJob(env, "id").(*cloudHostReadyJob)
+
+	for _, awsErr := range []string{
+		// Singular form, single instance.
+		"InvalidInstanceID.NotFound: The instance ID 'i-0123456789abcdef0' does not exist",
+		// Extra quoted text elsewhere in the message, which would have
+		// broken the old single-quote-split parsing.
+		"RequestId: 'req-1234', api error InvalidInstanceID.NotFound: The instance IDs 'i-0123456789abcdef0, i-0fedcba9876543210' do not exist",
+	} {
+		assert.NoError(t, j.terminateUnknownHosts(ctx, awsErr), awsErr)
+	}
+}
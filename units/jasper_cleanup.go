@@ -10,6 +10,8 @@ import (
 	"github.com/mongodb/amboy/dependency"
 	"github.com/mongodb/amboy/job"
 	"github.com/mongodb/amboy/registry"
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 )
 
 const jasperManagerCleanupJobName = "jasper-manager-cleanup"
@@ -56,5 +58,10 @@ func (j *jasperManagerCleanup) Run(ctx context.Context) {
 		j.env = evergreen.GetEnvironment()
 	}
 
-	j.env.JasperManager().Clear(ctx)
+	cleared := j.env.JasperManager().Clear(ctx)
+	grip.Info(message.Fields{
+		"message": "cleared completed jasper processes",
+		"job":     jasperManagerCleanupJobName,
+		"cleared": cleared,
+	})
 }
@@ -285,7 +285,7 @@ func (j *patchIntentProcessor) finishPatch(ctx context.Context, patchDoc *patch.
 	project.BuildProjectTVPairs(patchDoc, j.intent.GetAlias())
 
 	if shouldTaskSync := len(patchDoc.SyncAtEndOpts.BuildVariants) != 0 || len(patchDoc.SyncAtEndOpts.Tasks) != 0; shouldTaskSync {
-		patchDoc.SyncAtEndOpts.VariantsTasks = patchDoc.ResolveSyncVariantTasks(project.GetAllVariantTasks())
+		patchDoc.SyncAtEndOpts.VariantsTasks = patchDoc.ResolveSyncVariantTasks(project.GetAllVariantTasks(), project.TaskTagsByName())
 		// If the user requested task sync in their patch, it should match at least
 		// one valid task in a build variant.
 		if len(patchDoc.SyncAtEndOpts.VariantsTasks) == 0 {
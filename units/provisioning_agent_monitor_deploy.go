@@ -22,7 +22,15 @@ import (
 
 const (
 	agentMonitorDeployJobName = "agent-monitor-deploy"
-	agentMonitorPutRetries    = 25
+	// defaultAgentMonitorPutRetries is used unless the host's distro
+	// overrides it in BootstrapSettings.AgentMonitorPutRetries.
+	defaultAgentMonitorPutRetries = 25
+
+	// agentMonitorDeployBackoff is the per-failure backoff added on top of
+	// the usual retry cadence, jittered and capped at
+	// maxAgentMonitorDeployBackoff.
+	agentMonitorDeployBackoff    = 20 * time.Second
+	maxAgentMonitorDeployBackoff = 30 * time.Minute
 )
 
 func init() {
@@ -146,10 +154,17 @@ func (j *agentMonitorDeployJob) Run(ctx context.Context) {
 					return
 				}
 
-				if err = j.disableHost(ctx, fmt.Sprintf("failed %d times to put agent monitor on host", agentMonitorPutRetries)); err != nil {
+				if err = j.disableHost(ctx, fmt.Sprintf("failed %d times to put agent monitor on host", j.agentMonitorPutRetries())); err != nil {
 					j.AddError(errors.Wrapf(err, "error marking host %s for termination", j.host.Id))
 					return
 				}
+			} else if err = j.backOffNextAttempt(); err != nil {
+				grip.Info(message.WrapError(err, message.Fields{
+					"message": "could not back off next agent monitor deploy attempt",
+					"distro":  j.host.Distro.Id,
+					"host_id": j.host.Id,
+					"job":     j.ID(),
+				}))
 			}
 			if err = j.host.SetNeedsNewAgentMonitor(true); err != nil {
 				grip.Info(message.WrapError(err, message.Fields{
@@ -198,15 +213,43 @@ func (j *agentMonitorDeployJob) disableHost(ctx context.Context, reason string)
 	return errors.Wrapf(HandlePoisonedHost(ctx, j.env, j.host, reason), "error terminating host %s", j.host.Id)
 }
 
+// agentMonitorPutRetries returns the maximum number of attempts to deploy the
+// agent monitor before the host is disabled, using the host's distro
+// override if set and falling back to the package default otherwise.
+func (j *agentMonitorDeployJob) agentMonitorPutRetries() int {
+	if j.host.Distro.BootstrapSettings.AgentMonitorPutRetries > 0 {
+		return j.host.Distro.BootstrapSettings.AgentMonitorPutRetries
+	}
+	return defaultAgentMonitorPutRetries
+}
+
 // checkNoRetries checks if the job has exhausted the maximum allowed attempts
 // to deploy the agent monitor.
 func (j *agentMonitorDeployJob) checkNoRetries() (bool, error) {
-	stat, err := event.GetRecentAgentMonitorDeployStatuses(j.host.Id, agentMonitorPutRetries)
+	retries := j.agentMonitorPutRetries()
+	stat, err := event.GetRecentAgentMonitorDeployStatuses(j.host.Id, retries)
 	if err != nil {
 		return false, errors.Wrap(err, "could not get recent agent monitor deploy statuses")
 	}
 
-	return stat.LastAttemptFailed() && stat.AllAttemptsFailed() && stat.Count >= agentMonitorPutRetries, nil
+	return stat.LastAttemptFailed() && stat.AllAttemptsFailed() && stat.Count >= retries, nil
+}
+
+// backOffNextAttempt pushes back the host's last communication time based on
+// the recent failure count, so a host that's genuinely down isn't hammered
+// with agent monitor deploy attempts at the usual fixed cadence.
+func (j *agentMonitorDeployJob) backOffNextAttempt() error {
+	stat, err := event.GetRecentAgentMonitorDeployStatuses(j.host.Id, j.agentMonitorPutRetries())
+	if err != nil {
+		return errors.Wrap(err, "could not get recent agent monitor deploy statuses")
+	}
+
+	backoff := utility.JitterInterval(time.Duration(stat.Failed) * agentMonitorDeployBackoff)
+	if backoff > maxAgentMonitorDeployBackoff {
+		backoff = maxAgentMonitorDeployBackoff
+	}
+
+	return errors.Wrap(j.host.SetLastCommunicatedAt(time.Now().Add(backoff)), "could not set backoff for next agent monitor deploy attempt")
 }
 
 // checkAgentMonitor returns whether or not the agent monitor is already
@@ -343,6 +386,19 @@ func (j *agentMonitorDeployJob) deployMessage() message.Fields {
 		m["instance"] = j.host.InstanceType
 	}
 
+	retries := j.agentMonitorPutRetries()
+	stat, err := event.GetRecentAgentMonitorDeployStatuses(j.host.Id, retries)
+	if err != nil {
+		grip.Error(message.WrapError(err, message.Fields{
+			"message": "could not get recent agent monitor deploy statuses for deploy message",
+			"host_id": j.host.Id,
+			"job":     j.ID(),
+		}))
+	} else {
+		m["attempt"] = stat.Count + 1
+		m["attempts_remaining"] = retries - stat.Count - 1
+	}
+
 	sinceLCT := time.Since(j.host.LastCommunicationTime)
 	if j.host.NeedsNewAgentMonitor {
 		m["reason"] = "flagged for new agent monitor"
@@ -103,6 +103,23 @@ func ExtractInfosResponse(input json.RawMessage) (InfosResponse, error) {
 	return resp, resp.successOrError()
 }
 
+// ClearResponse represents CLI-specific output containing the request
+// outcome and the number of processes removed by the clear.
+type ClearResponse struct {
+	OutcomeResponse `json:"outcome"`
+	Cleared         int `json:"cleared,omitempty"`
+}
+
+// ExtractClearResponse unmarshals the input bytes into a ClearResponse and
+// checks if the request was successful.
+func ExtractClearResponse(input json.RawMessage) (ClearResponse, error) {
+	var resp ClearResponse
+	if err := json.Unmarshal(input, &resp); err != nil {
+		return resp, errors.Wrap(err, unmarshalFailed)
+	}
+	return resp, resp.successOrError()
+}
+
 // TagsResponse represents CLI-specific output containing the request outcome
 // and tags.
 type TagsResponse struct {
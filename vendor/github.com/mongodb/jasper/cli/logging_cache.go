@@ -150,8 +150,8 @@ func loggingCachePrune() cli.Command {
 				if lc == nil {
 					return makeOutcomeResponse(errors.New("logging cache not supported"))
 				}
-				lc.Prune(input.LastAccessed)
-				return makeOutcomeResponse(nil)
+				_, err := lc.Prune(input.LastAccessed)
+				return makeOutcomeResponse(err)
 			})
 		},
 	}
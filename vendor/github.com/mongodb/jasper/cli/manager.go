@@ -172,8 +172,8 @@ func managerClear() cli.Command {
 		Before: clientBefore(),
 		Action: func(c *cli.Context) error {
 			return doPassthroughOutput(c, func(ctx context.Context, client remote.Manager) interface{} {
-				client.Clear(ctx)
-				return makeOutcomeResponse(nil)
+				cleared := client.Clear(ctx)
+				return &ClearResponse{Cleared: cleared, OutcomeResponse: *makeOutcomeResponse(nil)}
 			})
 		},
 	}
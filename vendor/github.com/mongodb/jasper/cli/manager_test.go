@@ -125,7 +125,7 @@ func TestCLIManager(t *testing.T) {
 					assert.Len(t, resp.Infos, 0)
 				},
 				"ClearPasses": func(ctx context.Context, t *testing.T, c *cli.Context, jasperProcID string) {
-					resp := &OutcomeResponse{}
+					resp := &ClearResponse{}
 					require.NoError(t, execCLICommandOutput(t, c, managerClear(), resp))
 					assert.True(t, resp.Successful())
 				},
@@ -185,10 +185,20 @@ func (c *sshClient) Get(ctx context.Context, id string) (jasper.Process, error)
 	return newSSHProcess(c.client, resp.Info)
 }
 
-func (c *sshClient) Clear(ctx context.Context) {
-	if _, err := c.runManagerCommand(ctx, ClearCommand, nil); err != nil {
+func (c *sshClient) Clear(ctx context.Context) int {
+	output, err := c.runManagerCommand(ctx, ClearCommand, nil)
+	if err != nil {
+		grip.Debug(errors.Wrap(err, "clearing manager"))
+		return 0
+	}
+
+	resp, err := ExtractClearResponse(output)
+	if err != nil {
 		grip.Debug(errors.Wrap(err, "clearing manager"))
+		return 0
 	}
+
+	return resp.Cleared
 }
 
 func (c *sshClient) Close(ctx context.Context) error {
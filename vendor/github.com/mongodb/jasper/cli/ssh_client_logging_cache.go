@@ -42,6 +42,14 @@ func (lc *sshLoggingCache) Create(id string, opts *options.Output) (*options.Cac
 	return &resp.Logger, nil
 }
 
+func (lc *sshLoggingCache) GetOrCreate(id string, opts *options.Output) (*options.CachedLogger, error) {
+	if logger := lc.Get(id); logger != nil {
+		return logger, nil
+	}
+
+	return lc.Create(id, opts)
+}
+
 func (lc *sshLoggingCache) Put(id string, cl *options.CachedLogger) error {
 	return errors.New("operation not supported for remote managers")
 }
@@ -94,16 +102,18 @@ func (lc *sshLoggingCache) Clear(ctx context.Context) error {
 	return err
 }
 
-func (lc *sshLoggingCache) Prune(ts time.Time) {
+func (lc *sshLoggingCache) Prune(ts time.Time) (int, error) {
 	output, err := lc.runCommand(lc.ctx, LoggingCachePruneCommand, LoggingCachePruneInput{LastAccessed: ts})
 	if err != nil {
-		grip.Warning(errors.Wrap(err, "running command"))
-		return
+		return -1, errors.Wrap(err, "running command")
 	}
 
 	if _, err = ExtractOutcomeResponse(output); err != nil {
-		grip.Warning(errors.Wrap(err, "reading outcome response"))
+		return -1, errors.Wrap(err, "reading outcome response")
 	}
+
+	// The remote service does not report how many loggers were pruned.
+	return -1, nil
 }
 
 func (lc *sshLoggingCache) Len() int {
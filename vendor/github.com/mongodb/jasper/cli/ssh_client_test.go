@@ -275,9 +275,9 @@ func TestSSHClient(t *testing.T) {
 				t, client,
 				[]string{ManagerCommand, ClearCommand},
 				nil,
-				&struct{}{},
+				&ClearResponse{Cleared: 1, OutcomeResponse: *makeOutcomeResponse(nil)},
 			)
-			client.Clear(ctx)
+			assert.Equal(t, 1, client.Clear(ctx))
 		},
 		"ClosePassesWithValidResponse": func(ctx context.Context, t *testing.T, client *sshClient, baseManager *mock.Manager) {
 			baseManager.Create = makeCreateFunc(
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/mongodb/jasper/options"
+	"github.com/pkg/errors"
 )
 
 const (
@@ -37,13 +38,75 @@ type Manager interface {
 	List(context.Context, options.Filter) ([]Process, error)
 	Group(context.Context, string) ([]Process, error)
 	Get(context.Context, string) (Process, error)
-	Clear(context.Context)
+	// Clear removes all completed processes from the manager and returns the
+	// number of processes removed.
+	Clear(context.Context) int
 	Close(context.Context) error
 
 	LoggingCache(context.Context) LoggingCache
 	WriteFile(ctx context.Context, opts options.WriteFile) error
 }
 
+// GroupAll returns the processes managed by m that are tagged with every tag
+// in tags, i.e. the intersection of Group's results for each tag. It's
+// implemented in terms of repeated Group calls rather than a new Manager
+// method, so it works against any existing Manager implementation. An empty
+// tags list returns no processes, matching Group's behavior for a tag that
+// matches nothing.
+func GroupAll(ctx context.Context, m Manager, tags ...string) ([]Process, error) {
+	if len(tags) == 0 {
+		return []Process{}, nil
+	}
+
+	procs, err := m.Group(ctx, tags[0])
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	for _, tag := range tags[1:] {
+		if ctx.Err() != nil {
+			return nil, errors.WithStack(ctx.Err())
+		}
+
+		tagged, err := m.Group(ctx, tag)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		procs = intersectProcessesByID(procs, tagged)
+	}
+
+	return procs, nil
+}
+
+// OwnsProcess returns whether info describes a process that was started by
+// m, as opposed to one m has merely adopted (e.g. by Register), by checking
+// whether info's environment carries m's ManagerEnvironID value. This lets a
+// manager distinguish processes it's responsible for terminating from ones
+// it isn't, which matters for safe Close semantics on a host shared by
+// multiple managers.
+func OwnsProcess(m Manager, info ProcessInfo) bool {
+	value, ok := info.Options.Environment[ManagerEnvironID]
+	return ok && value == m.ID()
+}
+
+// intersectProcessesByID returns the processes in a that also appear in b,
+// matched by Process.ID.
+func intersectProcessesByID(a, b []Process) []Process {
+	ids := make(map[string]bool, len(b))
+	for _, p := range b {
+		ids[p.ID()] = true
+	}
+
+	out := []Process{}
+	for _, p := range a {
+		if ids[p.ID()] {
+			out = append(out, p)
+		}
+	}
+
+	return out
+}
+
 // Process objects reflect ways of starting and managing
 // processes. Process generally reflect only the primary process at
 // the top of a tree and "child" processes are not directly
@@ -15,6 +15,10 @@ import (
 type LoggingCache interface {
 	// Create creates and caches a new logger based on the given output options.
 	Create(id string, opts *options.Output) (*options.CachedLogger, error)
+	// GetOrCreate atomically gets an existing cached logger or creates and
+	// caches a new one based on the given output options if it does not
+	// already exist.
+	GetOrCreate(id string, opts *options.Output) (*options.CachedLogger, error)
 	// Put adds an existing logger to the cache.
 	Put(id string, logger *options.CachedLogger) error
 	// Get gets an existing cached logger. Implementations should return nil if
@@ -26,15 +30,34 @@ type LoggingCache interface {
 	// logging cache.
 	CloseAndRemove(ctx context.Context, id string) error
 	// Clear closes and removes any remaining loggers in the logging cache.
+	// It stops early and returns a partial error if ctx is canceled before
+	// all loggers have been closed.
 	Clear(ctx context.Context) error
-	// Prune removes all loggers that were last accessed before the given
-	// timestamp.
-	Prune(lastAccessed time.Time)
+	// Prune closes and removes all loggers that were last accessed before
+	// the given timestamp. It returns the number of loggers removed and any
+	// errors encountered while closing them.
+	Prune(lastAccessed time.Time) (int, error)
 	// Len returns the number of loggers. Implementations should return
 	// -1 if the length cannot be retrieved successfully.
 	Len() int
 }
 
+// LoggingCacheStats reports cumulative Get hit/miss counts for a logging
+// cache. It is only populated for caches with stats tracking enabled (see
+// NewLoggingCacheWithStats); otherwise both counts are always zero.
+type LoggingCacheStats struct {
+	Hits   int
+	Misses int
+}
+
+// StatsLoggingCache is implemented by LoggingCache implementations that
+// optionally track Get hit/miss statistics. Callers can type-assert a
+// LoggingCache to this interface to retrieve its Stats.
+type StatsLoggingCache interface {
+	LoggingCache
+	Stats() LoggingCacheStats
+}
+
 // NewLoggingCache produces a thread-safe implementation of a local logging
 // cache for use in manager implementations.
 func NewLoggingCache() LoggingCache {
@@ -43,9 +66,80 @@ func NewLoggingCache() LoggingCache {
 	}
 }
 
+// NewLoggingCacheWithStats produces a thread-safe implementation of a local
+// logging cache, like NewLoggingCache, that additionally tracks Get hit/miss
+// counts, retrievable via Stats. Stats tracking is opt-in so that callers
+// who don't need it avoid the bookkeeping overhead on the Get hot path.
+func NewLoggingCacheWithStats() StatsLoggingCache {
+	return &loggingCacheImpl{
+		cache:        map[string]*options.CachedLogger{},
+		statsEnabled: true,
+	}
+}
+
+// NewLoggingCacheWithCapacity produces a thread-safe implementation of a
+// local logging cache, like NewLoggingCache, that additionally bounds the
+// cache to the given number of loggers. Once the cache is at capacity,
+// caching a new logger via Create, GetOrCreate, or Put closes and evicts the
+// least-recently-accessed logger to make room.
+func NewLoggingCacheWithCapacity(capacity int) LoggingCache {
+	return &loggingCacheImpl{
+		cache:    map[string]*options.CachedLogger{},
+		capacity: capacity,
+		bounded:  true,
+	}
+}
+
 type loggingCacheImpl struct {
-	cache map[string]*options.CachedLogger
-	mu    sync.RWMutex
+	cache        map[string]*options.CachedLogger
+	mu           sync.RWMutex
+	capacity     int
+	bounded      bool
+	statsEnabled bool
+	hits         int
+	misses       int
+}
+
+// Stats returns the cumulative Get hit/miss counts for the cache. The
+// counts are always zero unless stats tracking was enabled at construction
+// (see NewLoggingCacheWithStats).
+func (c *loggingCacheImpl) Stats() LoggingCacheStats {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return LoggingCacheStats{Hits: c.hits, Misses: c.misses}
+}
+
+// evictForCapacity evicts (closing) the least-recently-accessed loggers,
+// if necessary, to make room for one more logger under the cache's
+// configured capacity. It must be called while holding c.mu. It returns an
+// error if the cache's capacity is too small to ever hold another logger.
+func (c *loggingCacheImpl) evictForCapacity() error {
+	if !c.bounded {
+		return nil
+	}
+	if c.capacity < 1 {
+		return errors.Errorf("cannot cache logger: cache capacity is %d", c.capacity)
+	}
+
+	for len(c.cache) >= c.capacity {
+		var oldestID string
+		var oldestAccessed time.Time
+		for id, logger := range c.cache {
+			if oldestID == "" || logger.Accessed.Before(oldestAccessed) {
+				oldestID = id
+				oldestAccessed = logger.Accessed
+			}
+		}
+
+		err := c.cache[oldestID].Close()
+		delete(c.cache, oldestID)
+		if err != nil {
+			return errors.Wrapf(err, "closing evicted logger '%s'", oldestID)
+		}
+	}
+
+	return nil
 }
 
 func (c *loggingCacheImpl) Create(id string, opts *options.Output) (*options.CachedLogger, error) {
@@ -55,6 +149,10 @@ func (c *loggingCacheImpl) Create(id string, opts *options.Output) (*options.Cac
 	if _, ok := c.cache[id]; ok {
 		return nil, errors.Errorf("logger named %s exists", id)
 	}
+	if err := c.evictForCapacity(); err != nil {
+		return nil, err
+	}
+
 	logger := opts.CachedLogger(id)
 
 	c.cache[id] = logger
@@ -62,6 +160,25 @@ func (c *loggingCacheImpl) Create(id string, opts *options.Output) (*options.Cac
 	return logger, nil
 }
 
+func (c *loggingCacheImpl) GetOrCreate(id string, opts *options.Output) (*options.CachedLogger, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if logger, ok := c.cache[id]; ok {
+		logger.Accessed = time.Now()
+		return logger, nil
+	}
+
+	if err := c.evictForCapacity(); err != nil {
+		return nil, err
+	}
+
+	logger := opts.CachedLogger(id)
+	c.cache[id] = logger
+
+	return logger, nil
+}
+
 func (c *loggingCacheImpl) Len() int {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -69,26 +186,39 @@ func (c *loggingCacheImpl) Len() int {
 	return len(c.cache)
 }
 
-func (c *loggingCacheImpl) Prune(ts time.Time) {
+func (c *loggingCacheImpl) Prune(ts time.Time) (int, error) {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
+	catcher := grip.NewBasicCatcher()
+	removed := 0
 	for k, v := range c.cache {
 		if v.Accessed.Before(ts) {
+			catcher.Add(v.Close())
 			delete(c.cache, k)
+			removed++
 		}
 	}
+
+	return removed, errors.Wrap(catcher.Resolve(), "problem closing pruned loggers")
 }
 
 func (c *loggingCacheImpl) Get(id string) *options.CachedLogger {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
-	if _, ok := c.cache[id]; !ok {
+	item, ok := c.cache[id]
+	if c.statsEnabled {
+		if ok {
+			c.hits++
+		} else {
+			c.misses++
+		}
+	}
+	if !ok {
 		return nil
 	}
 
-	item := c.cache[id]
 	item.Accessed = time.Now()
 	c.cache[id] = item
 	return item
@@ -105,6 +235,9 @@ func (c *loggingCacheImpl) Put(id string, logger *options.CachedLogger) error {
 	if _, ok := c.cache[id]; ok {
 		return errors.Errorf("cannot cache with existing logger '%s'", id)
 	}
+	if err := c.evictForCapacity(); err != nil {
+		return err
+	}
 
 	logger.Accessed = time.Now()
 
@@ -134,15 +267,22 @@ func (c *loggingCacheImpl) CloseAndRemove(_ context.Context, id string) error {
 	return errors.Wrapf(err, "problem closing logger with id %s", id)
 }
 
-func (c *loggingCacheImpl) Clear(_ context.Context) error {
+func (c *loggingCacheImpl) Clear(ctx context.Context) error {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	catcher := grip.NewBasicCatcher()
-	for _, logger := range c.cache {
+	for id, logger := range c.cache {
+		select {
+		case <-ctx.Done():
+			catcher.Add(errors.Wrap(ctx.Err(), "logger cache clear canceled before all loggers were closed"))
+			return errors.Wrap(catcher.Resolve(), "problem clearing logger cache")
+		default:
+		}
+
 		catcher.Add(logger.Close())
+		delete(c.cache, id)
 	}
-	c.cache = map[string]*options.CachedLogger{}
 
 	return errors.Wrap(catcher.Resolve(), "problem clearing logger cache")
 }
@@ -5,11 +5,31 @@ import (
 	"testing"
 	"time"
 
+	"github.com/mongodb/grip/send"
 	"github.com/mongodb/jasper/options"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
+// slowClosingSender is a send.Sender whose Close blocks for the configured
+// delay before returning, used to simulate a logger that would otherwise
+// make Clear hang.
+type slowClosingSender struct {
+	*send.Base
+	delay  time.Duration
+	closed bool
+}
+
+func newSlowClosingSender(delay time.Duration) *slowClosingSender {
+	return &slowClosingSender{Base: send.NewBase("slow"), delay: delay}
+}
+
+func (s *slowClosingSender) Close() error {
+	time.Sleep(s.delay)
+	s.closed = true
+	return nil
+}
+
 func TestLoggingCacheImplementation(t *testing.T) {
 	for _, test := range []struct {
 		Name string
@@ -52,12 +72,20 @@ func TestLoggingCacheImplementation(t *testing.T) {
 		{
 			Name: "Prune",
 			Case: func(t *testing.T, cache LoggingCache) {
-				assert.NoError(t, cache.Put("id", &options.CachedLogger{ID: "id"}))
+				sender := options.NewMockSender("output")
+				assert.NoError(t, cache.Put("id", &options.CachedLogger{ID: "id", Output: sender}))
 				assert.Equal(t, 1, cache.Len())
-				cache.Prune(time.Now().Add(-time.Minute))
+
+				removed, err := cache.Prune(time.Now().Add(-time.Minute))
+				assert.NoError(t, err)
+				assert.Equal(t, 0, removed)
 				assert.Equal(t, 1, cache.Len())
-				cache.Prune(time.Now().Add(time.Minute))
+
+				removed, err = cache.Prune(time.Now().Add(time.Minute))
+				assert.NoError(t, err)
+				assert.Equal(t, 1, removed)
 				assert.Equal(t, 0, cache.Len())
+				assert.True(t, sender.Closed)
 			},
 		},
 		{
@@ -81,6 +109,29 @@ func TestLoggingCacheImplementation(t *testing.T) {
 				assert.True(t, time.Since(cl.Accessed) <= time.Millisecond)
 			},
 		},
+		{
+			Name: "GetOrCreateCreatesWhenMissing",
+			Case: func(t *testing.T, cache LoggingCache) {
+				cl, err := cache.GetOrCreate("id", &options.Output{})
+				require.NoError(t, err)
+				require.NotNil(t, cl)
+				assert.Equal(t, 1, cache.Len())
+			},
+		},
+		{
+			Name: "GetOrCreateReturnsExisting",
+			Case: func(t *testing.T, cache LoggingCache) {
+				cl, err := cache.Create("id", &options.Output{})
+				require.NoError(t, err)
+				require.NotNil(t, cl)
+
+				cl2, err := cache.GetOrCreate("id", &options.Output{})
+				require.NoError(t, err)
+				require.NotNil(t, cl2)
+				assert.Equal(t, cl.ID, cl2.ID)
+				assert.Equal(t, 1, cache.Len())
+			},
+		},
 		{
 			Name: "CloseAndRemove",
 			Case: func(t *testing.T, cache LoggingCache) {
@@ -147,3 +198,71 @@ func TestLoggingCacheImplementation(t *testing.T) {
 		})
 	}
 }
+
+func TestLoggingCacheWithCapacity(t *testing.T) {
+	t.Run("EvictsLeastRecentlyAccessed", func(t *testing.T) {
+		cache := NewLoggingCacheWithCapacity(2)
+
+		cl0, err := cache.Create("id0", &options.Output{})
+		require.NoError(t, err)
+		cl0.Accessed = time.Now().Add(-time.Minute)
+
+		_, err = cache.Create("id1", &options.Output{})
+		require.NoError(t, err)
+		require.Equal(t, 2, cache.Len())
+
+		_, err = cache.Create("id2", &options.Output{})
+		require.NoError(t, err)
+
+		assert.Equal(t, 2, cache.Len())
+		assert.Nil(t, cache.Get("id0"), "least-recently-accessed logger should have been evicted")
+		assert.NotNil(t, cache.Get("id1"))
+		assert.NotNil(t, cache.Get("id2"))
+	})
+	t.Run("ErrorsWhenLoggerCannotFit", func(t *testing.T) {
+		cache := NewLoggingCacheWithCapacity(0)
+
+		cl, err := cache.Create("id", &options.Output{})
+		assert.Error(t, err)
+		assert.Nil(t, cl)
+	})
+}
+
+func TestLoggingCacheStats(t *testing.T) {
+	t.Run("DisabledByDefault", func(t *testing.T) {
+		cache := NewLoggingCache()
+		require.NoError(t, cache.Put("id", &options.CachedLogger{ID: "id"}))
+		cache.Get("id")
+		cache.Get("nonexistent")
+
+		statsCache, ok := cache.(StatsLoggingCache)
+		require.True(t, ok)
+		assert.Equal(t, LoggingCacheStats{}, statsCache.Stats())
+	})
+	t.Run("TracksHitsAndMisses", func(t *testing.T) {
+		cache := NewLoggingCacheWithStats()
+		require.NoError(t, cache.Put("id", &options.CachedLogger{ID: "id"}))
+
+		cache.Get("id")
+		cache.Get("id")
+		cache.Get("nonexistent")
+
+		assert.Equal(t, LoggingCacheStats{Hits: 2, Misses: 1}, cache.Stats())
+	})
+}
+
+func TestLoggingCacheClearRespectsContextCancellation(t *testing.T) {
+	cache := NewLoggingCache()
+	slowSender := newSlowClosingSender(5 * time.Second)
+	require.NoError(t, cache.Put("slow", &options.CachedLogger{ID: "slow", Output: slowSender}))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	start := time.Now()
+	err := cache.Clear(ctx)
+	assert.Less(t, time.Since(start), time.Second, "Clear should not block on a canceled context")
+	assert.Error(t, err)
+	assert.False(t, slowSender.closed, "logger should not have been closed once the context was canceled")
+	assert.Equal(t, 1, cache.Len(), "logger should remain in the cache since it was never closed")
+}
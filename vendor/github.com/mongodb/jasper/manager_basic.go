@@ -172,13 +172,17 @@ func (m *basicProcessManager) Get(ctx context.Context, id string) (Process, erro
 	return proc, nil
 }
 
-func (m *basicProcessManager) Clear(ctx context.Context) {
+func (m *basicProcessManager) Clear(ctx context.Context) int {
+	cleared := 0
 	for procID, proc := range m.procs {
 		if proc.Complete(ctx) {
 			delete(m.procs, procID)
 			m.loggers.Remove(procID)
+			cleared++
 		}
 	}
+
+	return cleared
 }
 
 func (m *basicProcessManager) Close(ctx context.Context) error {
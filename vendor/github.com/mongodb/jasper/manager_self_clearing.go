@@ -2,7 +2,10 @@ package jasper
 
 import (
 	"context"
+	"time"
 
+	"github.com/mongodb/grip"
+	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper/options"
 	"github.com/pkg/errors"
 )
@@ -10,6 +13,7 @@ import (
 type selfClearingProcessManager struct {
 	*basicProcessManager
 	maxProcs int
+	ttl      time.Duration
 }
 
 // NewSelfClearingProcessManager creates and returns a process manager that
@@ -56,10 +60,71 @@ func NewSSHLibrarySelfClearingProcessManager(maxProcs int, trackProcs bool) (Man
 	}, nil
 }
 
+// NewSelfClearingProcessManagerWithTTL is the same as
+// NewSelfClearingProcessManager, but additionally evicts completed processes
+// older than ttl during CreateProcess, Register, and List, so stale completed
+// processes don't linger indefinitely while the count stays below maxProcs.
+func NewSelfClearingProcessManagerWithTTL(maxProcs int, trackProcs bool, ttl time.Duration) (Manager, error) {
+	m, err := NewSelfClearingProcessManager(maxProcs, trackProcs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	m.(*selfClearingProcessManager).ttl = ttl
+
+	return m, nil
+}
+
+// NewSSHLibrarySelfClearingProcessManagerWithTTL is the same as
+// NewSelfClearingProcessManagerWithTTL but uses the SSH library instead of
+// the SSH binary for remote processes.
+func NewSSHLibrarySelfClearingProcessManagerWithTTL(maxProcs int, trackProcs bool, ttl time.Duration) (Manager, error) {
+	m, err := NewSSHLibrarySelfClearingProcessManager(maxProcs, trackProcs)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	m.(*selfClearingProcessManager).ttl = ttl
+
+	return m, nil
+}
+
+// evictStaleProcs removes completed processes whose EndAt is older than the
+// manager's ttl and returns how many were removed. It's a no-op when ttl is
+// unset.
+func (m *selfClearingProcessManager) evictStaleProcs(ctx context.Context) int {
+	if m.ttl <= 0 {
+		return 0
+	}
+
+	evicted := 0
+	for procID, proc := range m.procs {
+		info := proc.Info(ctx)
+		if info.Complete && time.Since(info.EndAt) > m.ttl {
+			delete(m.procs, procID)
+			m.loggers.Remove(procID)
+			evicted++
+		}
+	}
+
+	return evicted
+}
+
 func (m *selfClearingProcessManager) checkProcCapacity(ctx context.Context) error {
+	if evicted := m.evictStaleProcs(ctx); evicted > 0 {
+		grip.Debug(message.Fields{
+			"message": "self clearing manager evicted processes older than its ttl",
+			"ttl":     m.ttl,
+			"evicted": evicted,
+		})
+	}
+
 	if len(m.basicProcessManager.procs) == m.maxProcs {
 		// We are at capacity, we can try to perform a clear.
-		m.Clear(ctx)
+		cleared := m.Clear(ctx)
+		grip.Debug(message.Fields{
+			"message":  "self clearing manager is at capacity, attempted a clear",
+			"max_proc": m.maxProcs,
+			"cleared":  cleared,
+		})
 		if len(m.basicProcessManager.procs) == m.maxProcs {
 			return errors.New("cannot create any more processes")
 		}
@@ -88,3 +153,9 @@ func (m *selfClearingProcessManager) Register(ctx context.Context, proc Process)
 
 	return errors.WithStack(m.basicProcessManager.Register(ctx, proc))
 }
+
+func (m *selfClearingProcessManager) List(ctx context.Context, f options.Filter) ([]Process, error) {
+	m.evictStaleProcs(ctx)
+
+	return m.basicProcessManager.List(ctx, f)
+}
@@ -3,6 +3,7 @@ package jasper
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/mongodb/jasper/options"
 	"github.com/mongodb/jasper/testutil"
@@ -116,3 +117,60 @@ func TestSelfClearingManager(t *testing.T) {
 		})
 	}
 }
+
+func TestSelfClearingManagerWithTTL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), testutil.ManagerTestTimeout)
+	defer cancel()
+
+	t.Run("EvictsCompletedProcessesOlderThanTTL", func(t *testing.T) {
+		m, err := NewSelfClearingProcessManagerWithTTL(5, false, 10*time.Millisecond)
+		require.NoError(t, err)
+		manager := m.(*selfClearingProcessManager)
+		defer func() {
+			assert.NoError(t, manager.Close(ctx))
+		}()
+
+		proc, err := manager.CreateProcess(ctx, testutil.TrueCreateOpts())
+		require.NoError(t, err)
+		_, err = proc.Wait(ctx)
+		require.NoError(t, err)
+
+		time.Sleep(20 * time.Millisecond)
+
+		procs, err := manager.List(ctx, options.All)
+		require.NoError(t, err)
+		assert.Len(t, procs, 0)
+	})
+	t.Run("KeepsCompletedProcessesYoungerThanTTL", func(t *testing.T) {
+		m, err := NewSelfClearingProcessManagerWithTTL(5, false, time.Hour)
+		require.NoError(t, err)
+		manager := m.(*selfClearingProcessManager)
+		defer func() {
+			assert.NoError(t, manager.Close(ctx))
+		}()
+
+		proc, err := manager.CreateProcess(ctx, testutil.TrueCreateOpts())
+		require.NoError(t, err)
+		_, err = proc.Wait(ctx)
+		require.NoError(t, err)
+
+		procs, err := manager.List(ctx, options.All)
+		require.NoError(t, err)
+		assert.Len(t, procs, 1)
+	})
+	t.Run("ZeroTTLDoesNotEvict", func(t *testing.T) {
+		m, err := NewSelfClearingProcessManager(5, false)
+		require.NoError(t, err)
+		manager := m.(*selfClearingProcessManager)
+		defer func() {
+			assert.NoError(t, manager.Close(ctx))
+		}()
+
+		proc, err := manager.CreateProcess(ctx, testutil.TrueCreateOpts())
+		require.NoError(t, err)
+		_, err = proc.Wait(ctx)
+		require.NoError(t, err)
+
+		assert.Zero(t, manager.evictStaleProcs(ctx))
+	})
+}
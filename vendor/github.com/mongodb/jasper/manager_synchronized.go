@@ -91,11 +91,11 @@ func (m *synchronizedProcessManager) Get(ctx context.Context, id string) (Proces
 	return &synchronizedProcess{proc: proc}, errors.WithStack(err)
 }
 
-func (m *synchronizedProcessManager) Clear(ctx context.Context) {
+func (m *synchronizedProcessManager) Clear(ctx context.Context) int {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	m.manager.Clear(ctx)
+	return m.manager.Clear(ctx)
 }
 
 func (m *synchronizedProcessManager) Close(ctx context.Context) error {
@@ -78,6 +78,22 @@ func TestManagerImplementations(t *testing.T) {
 					require.NotEmpty(t, info.Options.Environment)
 					assert.Equal(t, manager.ID(), info.Options.Environment[ManagerEnvironID])
 				},
+				"OwnsProcessIsTrueForProcessesItCreated": func(ctx context.Context, t *testing.T, manager Manager, modify testutil.OptsModify) {
+					opts := testutil.TrueCreateOpts()
+					modify(opts)
+					proc, err := manager.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					assert.True(t, OwnsProcess(manager, proc.Info(ctx)))
+				},
+				"OwnsProcessIsFalseForAnotherManagersID": func(ctx context.Context, t *testing.T, manager Manager, modify testutil.OptsModify) {
+					opts := testutil.TrueCreateOpts()
+					modify(opts)
+					proc, err := manager.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					info := proc.Info(ctx)
+					info.Options.Environment = map[string]string{ManagerEnvironID: "some-other-manager"}
+					assert.False(t, OwnsProcess(manager, info))
+				},
 				"CreateProcessFailsWithEmptyOptions": func(ctx context.Context, t *testing.T, manager Manager, modify testutil.OptsModify) {
 					opts := &options.Create{}
 					modify(opts)
@@ -229,6 +245,31 @@ func TestManagerImplementations(t *testing.T) {
 					require.Len(t, procs, 1)
 					assert.Equal(t, procs[0].ID(), proc.ID())
 				},
+				"GroupAllReturnsEmptyForNoTags": func(ctx context.Context, t *testing.T, manager Manager, modify testutil.OptsModify) {
+					procs, err := GroupAll(ctx, manager)
+					require.NoError(t, err)
+					assert.Len(t, procs, 0)
+				},
+				"GroupAllReturnsIntersectionOfTags": func(ctx context.Context, t *testing.T, manager Manager, modify testutil.OptsModify) {
+					opts := testutil.TrueCreateOpts()
+					modify(opts)
+
+					both, err := manager.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					both.Tag("host1")
+					both.Tag("role1")
+
+					opts = testutil.TrueCreateOpts()
+					modify(opts)
+					hostOnly, err := manager.CreateProcess(ctx, opts)
+					require.NoError(t, err)
+					hostOnly.Tag("host1")
+
+					procs, err := GroupAll(ctx, manager, "host1", "role1")
+					require.NoError(t, err)
+					require.Len(t, procs, 1)
+					assert.Equal(t, both.ID(), procs[0].ID())
+				},
 				"CloseEmptyManagerNoops": func(ctx context.Context, t *testing.T, manager Manager, modify testutil.OptsModify) {
 					assert.NoError(t, manager.Close(ctx))
 				},
@@ -385,7 +426,8 @@ func TestManagerImplementations(t *testing.T) {
 					require.Equal(t, proc.ID(), sameProc.ID())
 					_, err = proc.Wait(ctx)
 					require.NoError(t, err)
-					manager.Clear(ctx)
+					cleared := manager.Clear(ctx)
+					assert.Equal(t, 1, cleared)
 					nilProc, err := manager.Get(ctx, proc.ID())
 					require.Error(t, err)
 					assert.Nil(t, nilProc)
@@ -395,7 +437,8 @@ func TestManagerImplementations(t *testing.T) {
 					modify(opts)
 					proc, err := manager.CreateProcess(ctx, opts)
 					require.NoError(t, err)
-					manager.Clear(ctx)
+					cleared := manager.Clear(ctx)
+					assert.Equal(t, 0, cleared)
 					sameProc, err := manager.Get(ctx, proc.ID())
 					require.NoError(t, err)
 					assert.Equal(t, proc.ID(), sameProc.ID())
@@ -415,7 +458,8 @@ func TestManagerImplementations(t *testing.T) {
 					_, err = lsProc.Wait(ctx)
 					require.NoError(t, err)
 
-					manager.Clear(ctx)
+					cleared := manager.Clear(ctx)
+					assert.Equal(t, 1, cleared)
 
 					sameSleepProc, err := manager.Get(ctx, sleepProc.ID())
 					require.NoError(t, err)
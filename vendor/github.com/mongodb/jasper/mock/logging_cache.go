@@ -39,6 +39,18 @@ func (c *LoggingCache) Create(id string, opts *options.Output) (*options.CachedL
 	return c.Cache[id], nil
 }
 
+// GetOrCreate returns the existing cached logger with the given ID if it
+// exists, or creates and caches a new one from the given options.Output
+// otherwise. If FailCreate is set, it returns an error when the logger does
+// not already exist.
+func (c *LoggingCache) GetOrCreate(id string, opts *options.Output) (*options.CachedLogger, error) {
+	if logger, ok := c.Cache[id]; ok {
+		return logger, nil
+	}
+
+	return c.Create(id, opts)
+}
+
 // Put adds the given cached logger with the given ID to the cache. If
 // AllowPutOverwrite is set, the given logger will overwrite an existing one
 // with the same ID if it already exists. If FailPut is set, it returns an
@@ -88,14 +100,21 @@ func (c *LoggingCache) Clear(_ context.Context) error {
 	return catcher.Resolve()
 }
 
-// Prune removes all items from the cache whose most recent access time is older
-// than lastAccessed.
-func (c *LoggingCache) Prune(lastAccessed time.Time) {
+// Prune closes and removes all items from the cache whose most recent access
+// time is older than lastAccessed, returning the number removed and any
+// errors encountered while closing them.
+func (c *LoggingCache) Prune(lastAccessed time.Time) (int, error) {
+	catcher := grip.NewBasicCatcher()
+	removed := 0
 	for k, v := range c.Cache {
 		if v.Accessed.Before(lastAccessed) {
+			catcher.Add(v.Close())
 			delete(c.Cache, k)
+			removed++
 		}
 	}
+
+	return removed, catcher.Resolve()
 }
 
 // Len returns the size of the in-memory logging cache.
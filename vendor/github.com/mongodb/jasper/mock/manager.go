@@ -166,9 +166,11 @@ func (m *Manager) Get(ctx context.Context, id string) (jasper.Process, error) {
 	return nil, errors.Errorf("proc with id '%s' not found", id)
 }
 
-// Clear removes all processes from Procs.
-func (m *Manager) Clear(ctx context.Context) {
+// Clear removes all processes from Procs and returns how many were removed.
+func (m *Manager) Clear(ctx context.Context) int {
+	cleared := len(m.Procs)
 	m.Procs = []jasper.Process{}
+	return cleared
 }
 
 // Close clears all processes in Procs. If FailClose is set, it returns an
@@ -32,6 +32,9 @@ func NewInMemoryLogger(maxSize int) (*options.LoggerConfig, error) {
 type LogStream struct {
 	Logs []string `bson:"logs,omitempty" json:"logs,omitempty"`
 	Done bool     `bson:"done" json:"done"`
+	// Truncated indicates that the requested count exceeded the service's
+	// maximum allowed count and was clamped to it.
+	Truncated bool `bson:"truncated,omitempty" json:"truncated,omitempty"`
 }
 
 // GetInMemoryLogStream gets at most count logs from the in-memory output logs
@@ -327,7 +327,8 @@ func addBasicClientTests(modify testutil.OptsModify, tests ...clientTestCase) []
 				require.Equal(t, proc.ID(), sameProc.ID())
 				_, err = proc.Wait(ctx)
 				require.NoError(t, err)
-				client.Clear(ctx)
+				cleared := client.Clear(ctx)
+				assert.Equal(t, 1, cleared)
 				nilProc, err := client.Get(ctx, proc.ID())
 				require.Error(t, err)
 				assert.Nil(t, nilProc)
@@ -340,7 +341,8 @@ func addBasicClientTests(modify testutil.OptsModify, tests ...clientTestCase) []
 				modify(opts)
 				proc, err := client.CreateProcess(ctx, opts)
 				require.NoError(t, err)
-				client.Clear(ctx)
+				cleared := client.Clear(ctx)
+				assert.Equal(t, 0, cleared)
 				sameProc, err := client.Get(ctx, proc.ID())
 				require.NoError(t, err)
 				assert.Equal(t, proc.ID(), sameProc.ID())
@@ -363,7 +365,8 @@ func addBasicClientTests(modify testutil.OptsModify, tests ...clientTestCase) []
 				_, err = lsProc.Wait(ctx)
 				require.NoError(t, err)
 
-				client.Clear(ctx)
+				cleared := client.Clear(ctx)
+				assert.Equal(t, 1, cleared)
 
 				sameSleepProc, err := client.Get(ctx, sleepProc.ID())
 				require.NoError(t, err)
@@ -288,9 +288,11 @@ func (s *jasperService) Respawn(ctx context.Context, id *JasperProcessID) (*Proc
 }
 
 func (s *jasperService) Clear(ctx context.Context, _ *empty.Empty) (*OperationOutcome, error) {
-	s.manager.Clear(ctx)
+	cleared := s.manager.Clear(ctx)
 
-	return &OperationOutcome{Success: true}, nil
+	// ExitCode has no meaning for Clear; it's reused here to carry the number
+	// of processes removed so callers can log it without a proto change.
+	return &OperationOutcome{Success: true, ExitCode: int32(cleared)}, nil
 }
 
 func (s *jasperService) Close(ctx context.Context, _ *empty.Empty) (*OperationOutcome, error) {
@@ -746,7 +748,9 @@ func (s *jasperService) LoggingCachePrune(ctx context.Context, arg *timestamp.Ti
 		return nil, newGRPCError(codes.Internal, errors.Wrap(err, "converting timestamp"))
 	}
 
-	lc.Prune(ts)
+	if _, err := lc.Prune(ts); err != nil {
+		return nil, newGRPCError(codes.Internal, err)
+	}
 
 	return &OperationOutcome{Success: true}, nil
 }
@@ -244,22 +244,25 @@ func (c *mdbClient) Get(ctx context.Context, id string) (jasper.Process, error)
 	return &mdbProcess{info: info, doRequest: c.doRequest}, nil
 }
 
-func (c *mdbClient) Clear(ctx context.Context) {
+func (c *mdbClient) Clear(ctx context.Context) int {
 	req, err := shell.RequestToMessage(mongowire.OP_QUERY, &clearRequest{Clear: 1})
 	if err != nil {
 		grip.Warning(message.WrapError(err, "could not create request"))
-		return
+		return 0
 	}
 	msg, err := c.doRequest(ctx, req)
 	if err != nil {
 		grip.Warning(message.WrapError(err, "failed during request"))
-		return
+		return 0
 	}
-	var resp shell.ErrorResponse
+	var resp clearResponse
 	if err := shell.MessageToResponse(msg, &resp); err != nil {
-		grip.Warning(message.WrapError(shell.MessageToResponse(msg, &resp), "could not read response"))
+		grip.Warning(message.WrapError(err, "could not read response"))
+		return 0
 	}
 	grip.Warning(message.WrapError(resp.SuccessOrError(), "error in response"))
+
+	return resp.Cleared
 }
 
 func (c *mdbClient) Close(ctx context.Context) error {
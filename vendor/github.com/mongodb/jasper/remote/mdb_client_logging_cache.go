@@ -42,6 +42,14 @@ func (lc *mdbLoggingCache) Create(id string, opts *options.Output) (*options.Cac
 	return &resp.CachedLogger, nil
 }
 
+func (lc *mdbLoggingCache) GetOrCreate(id string, opts *options.Output) (*options.CachedLogger, error) {
+	if logger := lc.Get(id); logger != nil {
+		return logger, nil
+	}
+
+	return lc.Create(id, opts)
+}
+
 func (lc *mdbLoggingCache) Put(_ string, _ *options.CachedLogger) error {
 	return errors.New("operation not supported for remote managers")
 }
@@ -115,13 +123,27 @@ func (lc *mdbLoggingCache) Clear(ctx context.Context) error {
 	return resp.SuccessOrError()
 }
 
-func (lc *mdbLoggingCache) Prune(lastAccessed time.Time) {
+func (lc *mdbLoggingCache) Prune(lastAccessed time.Time) (int, error) {
 	req, err := shell.RequestToMessage(mongowire.OP_QUERY, &loggingCachePruneRequest{LastAccessed: lastAccessed})
 	if err != nil {
-		return
+		return -1, errors.Wrap(err, "could not create request")
 	}
 
-	_, _ = lc.client.doRequest(lc.ctx, req)
+	msg, err := lc.client.doRequest(lc.ctx, req)
+	if err != nil {
+		return -1, errors.Wrap(err, "failed during request")
+	}
+
+	var resp shell.ErrorResponse
+	if err = shell.MessageToResponse(msg, &resp); err != nil {
+		return -1, errors.Wrap(err, "could not read response")
+	}
+	if err = resp.SuccessOrError(); err != nil {
+		return -1, errors.Wrap(err, "error in response")
+	}
+
+	// The remote service does not report how many loggers were pruned.
+	return -1, nil
 }
 
 func (lc *mdbLoggingCache) Len() int {
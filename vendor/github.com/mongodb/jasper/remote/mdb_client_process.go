@@ -111,6 +111,29 @@ func (p *mdbProcess) Signal(ctx context.Context, sig syscall.Signal) error {
 	return errors.Wrap(resp.SuccessOrError(), "error in response")
 }
 
+// SignalByName sends the signal given by its symbolic name (e.g. "SIGTERM")
+// to the process. It is a convenience for callers that would otherwise have
+// to hardcode signal numbers that differ across operating systems; the name
+// is resolved to the platform signal number server-side.
+func (p *mdbProcess) SignalByName(ctx context.Context, name string) error {
+	r := signalByNameRequest{}
+	r.Params.ID = p.ID()
+	r.Params.SignalName = name
+	req, err := shell.RequestToMessage(mongowire.OP_QUERY, r)
+	if err != nil {
+		return errors.Wrap(err, "could not create request")
+	}
+	msg, err := p.doRequest(ctx, req)
+	if err != nil {
+		return errors.Wrap(err, "failed during request")
+	}
+	var resp shell.ErrorResponse
+	if err := shell.MessageToResponse(msg, &resp); err != nil {
+		return errors.Wrapf(err, "failed to get signal by name response for process %s", p.ID())
+	}
+	return errors.Wrap(resp.SuccessOrError(), "error in response")
+}
+
 func (p *mdbProcess) Wait(ctx context.Context) (int, error) {
 	req, err := shell.RequestToMessage(mongowire.OP_QUERY, waitRequest{p.ID()})
 	if err != nil {
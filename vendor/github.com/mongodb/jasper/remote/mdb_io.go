@@ -91,6 +91,17 @@ type signalRequest struct {
 	} `bson:"signal"`
 }
 
+// signalByNameRequest represents a request to send a signal, given by its
+// symbolic name (e.g. "SIGTERM"), to the process given by ID. The name is
+// resolved to the platform signal number server-side, so that callers do not
+// need to hardcode signal numbers that can differ across operating systems.
+type signalByNameRequest struct {
+	Params struct {
+		ID         string `bson:"id"`
+		SignalName string `bson:"signal_name"`
+	} `bson:"signal_by_name"`
+}
+
 // registerSignalTriggerIDRequest represents a request to register the signal
 // trigger ID on the process given by ID.
 type registerSignalTriggerIDRequest struct {
@@ -188,6 +199,17 @@ type clearRequest struct {
 	Clear int `bson:"clear"`
 }
 
+// clearResponse represents a response indicating how many processes were
+// removed by a clearRequest.
+type clearResponse struct {
+	shell.ErrorResponse `bson:"error_response,inline"`
+	Cleared             int `bson:"cleared"`
+}
+
+func makeClearResponse(cleared int) clearResponse {
+	return clearResponse{Cleared: cleared, ErrorResponse: shell.MakeSuccessResponse()}
+}
+
 // closeRequest represents a request to terminate all processes.
 type closeRequest struct {
 	Close int `bson:"close"`
@@ -221,13 +243,29 @@ type getLogStreamResponse struct {
 	LogStream           jasper.LogStream `bson:"log_stream"`
 }
 
-func makeGetLogStreamResponse(logs []string, done bool) getLogStreamResponse {
+func makeGetLogStreamResponse(logs []string, done, truncated bool) getLogStreamResponse {
 	return getLogStreamResponse{
-		LogStream:     jasper.LogStream{Logs: logs, Done: done},
+		LogStream:     jasper.LogStream{Logs: logs, Done: done, Truncated: truncated},
 		ErrorResponse: shell.MakeSuccessResponse(),
 	}
 }
 
+// MaxLogStreamCount caps the number of log lines that a single getLogStream
+// request can return, protecting the service from excessive memory use when
+// a caller requests a very large count. It can be overridden to raise or
+// lower the cap.
+var MaxLogStreamCount = 10 * 1000
+
+// clampLogStreamCount caps count to MaxLogStreamCount, reporting whether it
+// was truncated.
+func clampLogStreamCount(count int) (clamped int, truncated bool) {
+	if count > MaxLogStreamCount {
+		return MaxLogStreamCount, true
+	}
+
+	return count, false
+}
+
 type getBuildloggerURLsRequest struct {
 	ID string `bson:"get_buildlogger_urls"`
 }
@@ -92,6 +92,7 @@ func (s *mdbService) registerHandlers() error {
 		CompleteCommand:                s.processComplete,
 		WaitCommand:                    s.processWait,
 		SignalCommand:                  s.processSignal,
+		SignalByNameCommand:            s.processSignalByName,
 		RegisterSignalTriggerIDCommand: s.processRegisterSignalTriggerID,
 		RespawnCommand:                 s.processRespawn,
 		TagCommand:                     s.processTag,
@@ -107,7 +107,10 @@ func (s *mdbService) loggingPrune(ctx context.Context, w io.Writer, msg mongowir
 		return
 	}
 
-	lc.Prune(req.LastAccessed)
+	if _, err := lc.Prune(req.LastAccessed); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, err, LoggingCachePruneCommand)
+		return
+	}
 
 	s.loggingCacheResponse(ctx, w, nil, LoggingCachePruneCommand)
 }
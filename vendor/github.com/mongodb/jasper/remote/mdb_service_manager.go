@@ -153,8 +153,14 @@ func (s *mdbService) managerGetProcess(ctx context.Context, w io.Writer, msg mon
 }
 
 func (s *mdbService) managerClear(ctx context.Context, w io.Writer, msg mongowire.Message) {
-	s.manager.Clear(ctx)
-	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, ClearCommand)
+	cleared := s.manager.Clear(ctx)
+
+	resp, err := shell.ResponseToMessage(mongowire.OP_REPLY, makeClearResponse(cleared))
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, errors.Wrap(err, "could not make response"), ClearCommand)
+		return
+	}
+	shell.WriteResponse(ctx, w, resp, ClearCommand)
 }
 
 func (s *mdbService) managerClose(ctx context.Context, w io.Writer, msg mongowire.Message) {
@@ -270,7 +276,7 @@ func (s *mdbService) getLogStream(ctx context.Context, w io.Writer, msg mongowir
 		return
 	}
 	id := req.Params.ID
-	count := req.Params.Count
+	count, truncated := clampLogStreamCount(req.Params.Count)
 
 	proc, err := s.manager.Get(ctx, id)
 	if err != nil {
@@ -287,7 +293,7 @@ func (s *mdbService) getLogStream(ctx context.Context, w io.Writer, msg mongowir
 		return
 	}
 
-	resp, err := shell.ResponseToMessage(mongowire.OP_REPLY, makeGetLogStreamResponse(logs, done))
+	resp, err := shell.ResponseToMessage(mongowire.OP_REPLY, makeGetLogStreamResponse(logs, done, truncated))
 	if err != nil {
 		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, errors.Wrap(err, "could not make response"), GetLogStreamCommand)
 		return
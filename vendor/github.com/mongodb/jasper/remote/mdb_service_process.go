@@ -20,6 +20,7 @@ const (
 	WaitCommand                    = "wait"
 	RespawnCommand                 = "respawn"
 	SignalCommand                  = "signal"
+	SignalByNameCommand            = "signal_by_name"
 	RegisterSignalTriggerIDCommand = "register_signal_trigger_id"
 	GetTagsCommand                 = "get_tags"
 	TagCommand                     = "add_tag"
@@ -184,6 +185,34 @@ func (s *mdbService) processSignal(ctx context.Context, w io.Writer, msg mongowi
 	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, SignalCommand)
 }
 
+func (s *mdbService) processSignalByName(ctx context.Context, w io.Writer, msg mongowire.Message) {
+	req := signalByNameRequest{}
+	if err := shell.MessageToRequest(msg, &req); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, errors.Wrap(err, "could not read request"), SignalByNameCommand)
+		return
+	}
+	id := req.Params.ID
+
+	sig, err := jasper.SignalByName(req.Params.SignalName)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, errors.Wrap(err, "could not resolve signal name"), SignalByNameCommand)
+		return
+	}
+
+	proc, err := s.manager.Get(ctx, id)
+	if err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, errors.Wrap(err, "could not get process"), SignalByNameCommand)
+		return
+	}
+
+	if err := proc.Signal(ctx, sig); err != nil {
+		shell.WriteErrorResponse(ctx, w, mongowire.OP_REPLY, errors.Wrap(err, "could not signal process"), SignalByNameCommand)
+		return
+	}
+
+	shell.WriteOKResponse(ctx, w, mongowire.OP_REPLY, SignalByNameCommand)
+}
+
 func (s *mdbService) processRegisterSignalTriggerID(ctx context.Context, w io.Writer, msg mongowire.Message) {
 	req := registerSignalTriggerIDRequest{}
 	if err := shell.MessageToRequest(msg, &req); err != nil {
@@ -284,14 +284,25 @@ func (c *restClient) Get(ctx context.Context, id string) (jasper.Process, error)
 	}, nil
 }
 
-func (c *restClient) Clear(ctx context.Context) {
-	// Avoid errors here, because we can't return them anyways, and these errors
-	// should not really ever happen.
+func (c *restClient) Clear(ctx context.Context) int {
+	// Avoid returning errors here, because we can't return them anyways, and
+	// these errors should not really ever happen.
 	resp, err := c.doRequest(ctx, http.MethodPost, c.getURL("/clear"), nil)
 	if err != nil {
 		grip.Debug(errors.Wrap(err, "request returned error"))
+		return 0
 	}
 	defer resp.Body.Close()
+
+	payload := struct {
+		Cleared int `json:"cleared"`
+	}{}
+	if err := gimlet.GetJSON(resp.Body, &payload); err != nil {
+		grip.Debug(errors.Wrap(err, "could not read response"))
+		return 0
+	}
+
+	return payload.Cleared
 }
 
 func (c *restClient) Close(ctx context.Context) error {
@@ -42,6 +42,14 @@ func (lc *restLoggingCache) Create(id string, opts *options.Output) (*options.Ca
 	return out, nil
 }
 
+func (lc *restLoggingCache) GetOrCreate(id string, opts *options.Output) (*options.CachedLogger, error) {
+	if logger := lc.Get(id); logger != nil {
+		return logger, nil
+	}
+
+	return lc.Create(id, opts)
+}
+
 func (lc *restLoggingCache) Put(id string, cl *options.CachedLogger) error {
 	return errors.New("operation not supported for remote managers")
 }
@@ -97,15 +105,19 @@ func (lc *restLoggingCache) Clear(ctx context.Context) error {
 	return errors.WithStack(handleError(resp))
 }
 
-func (lc *restLoggingCache) Prune(ts time.Time) {
+func (lc *restLoggingCache) Prune(ts time.Time) (int, error) {
 	resp, err := lc.client.doRequest(lc.ctx, http.MethodDelete, lc.client.getURL("/logging/prune/%s", ts.Format(time.RFC3339)), nil)
 	if err != nil {
-		grip.Debug(errors.Wrap(err, "request returned error"))
-		return
+		return -1, errors.Wrap(err, "request returned error")
 	}
 	defer resp.Body.Close()
 
-	grip.Debug(errors.WithStack(handleError(resp)))
+	if err := handleError(resp); err != nil {
+		return -1, errors.WithStack(err)
+	}
+
+	// The remote service does not report how many loggers were pruned.
+	return -1, nil
 }
 
 func (lc *restLoggingCache) Len() int {
@@ -650,8 +650,10 @@ func (s *Service) writeFile(rw http.ResponseWriter, r *http.Request) {
 }
 
 func (s *Service) clearManager(rw http.ResponseWriter, r *http.Request) {
-	s.manager.Clear(r.Context())
-	gimlet.WriteJSON(rw, struct{}{})
+	cleared := s.manager.Clear(r.Context())
+	gimlet.WriteJSON(rw, struct {
+		Cleared int `json:"cleared"`
+	}{Cleared: cleared})
 }
 
 func (s *Service) closeManager(rw http.ResponseWriter, r *http.Request) {
@@ -957,7 +959,13 @@ func (s *Service) loggingCachePrune(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	lc.Prune(ts)
+	if _, err = lc.Prune(ts); err != nil {
+		writeError(rw, gimlet.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    errors.Wrap(err, "problem pruning logging cache").Error(),
+		})
+		return
+	}
 
 	gimlet.WriteJSON(rw, struct{}{})
 }
@@ -187,8 +187,13 @@ func (c *rpcClient) Get(ctx context.Context, name string) (jasper.Process, error
 	return &rpcProcess{client: c.client, info: info}, nil
 }
 
-func (c *rpcClient) Clear(ctx context.Context) {
-	_, _ = c.client.Clear(ctx, &empty.Empty{})
+func (c *rpcClient) Clear(ctx context.Context) int {
+	resp, err := c.client.Clear(ctx, &empty.Empty{})
+	if err != nil {
+		return 0
+	}
+
+	return int(resp.ExitCode)
 }
 
 func (c *rpcClient) Close(ctx context.Context) error {
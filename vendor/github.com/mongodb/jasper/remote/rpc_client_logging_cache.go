@@ -6,8 +6,6 @@ import (
 
 	"github.com/golang/protobuf/ptypes"
 	empty "github.com/golang/protobuf/ptypes/empty"
-	"github.com/mongodb/grip"
-	"github.com/mongodb/grip/message"
 	"github.com/mongodb/jasper/options"
 	internal "github.com/mongodb/jasper/remote/internal"
 	"github.com/pkg/errors"
@@ -38,6 +36,14 @@ func (lc *rpcLoggingCache) Create(id string, opts *options.Output) (*options.Cac
 	return out, nil
 }
 
+func (lc *rpcLoggingCache) GetOrCreate(id string, opts *options.Output) (*options.CachedLogger, error) {
+	if logger := lc.Get(id); logger != nil {
+		return logger, nil
+	}
+
+	return lc.Create(id, opts)
+}
+
 func (lc *rpcLoggingCache) Put(id string, opts *options.CachedLogger) error {
 	return errors.New("operation not supported for remote managers")
 }
@@ -87,15 +93,17 @@ func (lc *rpcLoggingCache) Clear(ctx context.Context) error {
 	return nil
 }
 
-func (lc *rpcLoggingCache) Prune(ts time.Time) {
+func (lc *rpcLoggingCache) Prune(ts time.Time) (int, error) {
 	pbts, err := ptypes.TimestampProto(ts)
 	if err != nil {
-		grip.Warning(message.WrapError(err, message.Fields{
-			"message": "could not convert prune timestamp to equivalent protobuf RPC timestamp",
-		}))
-		return
+		return -1, errors.Wrap(err, "could not convert prune timestamp to equivalent protobuf RPC timestamp")
+	}
+	if _, err := lc.client.LoggingCachePrune(lc.ctx, pbts); err != nil {
+		return -1, errors.WithStack(err)
 	}
-	_, _ = lc.client.LoggingCachePrune(lc.ctx, pbts)
+
+	// The remote service does not report how many loggers were pruned.
+	return -1, nil
 }
 
 func (lc *rpcLoggingCache) Len() int {
@@ -0,0 +1,40 @@
+package jasper
+
+import (
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+// signalsByName maps signal names, without the "SIG" prefix, to their
+// corresponding syscall.Signal value. It is restricted to signals that are
+// defined across all platforms jasper supports so that callers don't have
+// to special-case unix versus Windows.
+var signalsByName = map[string]syscall.Signal{
+	"HUP":  syscall.SIGHUP,
+	"INT":  syscall.SIGINT,
+	"QUIT": syscall.SIGQUIT,
+	"ILL":  syscall.SIGILL,
+	"TRAP": syscall.SIGTRAP,
+	"ABRT": syscall.SIGABRT,
+	"BUS":  syscall.SIGBUS,
+	"FPE":  syscall.SIGFPE,
+	"KILL": syscall.SIGKILL,
+	"SEGV": syscall.SIGSEGV,
+	"PIPE": syscall.SIGPIPE,
+	"ALRM": syscall.SIGALRM,
+	"TERM": syscall.SIGTERM,
+}
+
+// SignalByName resolves a symbolic signal name (e.g. "SIGTERM" or "TERM",
+// case-insensitive) to its platform signal number. It returns an error if
+// the name is not recognized.
+func SignalByName(name string) (syscall.Signal, error) {
+	key := strings.TrimPrefix(strings.ToUpper(name), "SIG")
+	sig, ok := signalsByName[key]
+	if !ok {
+		return 0, errors.Errorf("unrecognized signal name '%s'", name)
+	}
+	return sig, nil
+}
@@ -0,0 +1,33 @@
+package jasper
+
+import (
+	"syscall"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignalByName(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		sig  syscall.Signal
+	}{
+		{name: "SIGTERM", sig: syscall.SIGTERM},
+		{name: "TERM", sig: syscall.SIGTERM},
+		{name: "sigterm", sig: syscall.SIGTERM},
+		{name: "SIGKILL", sig: syscall.SIGKILL},
+		{name: "kill", sig: syscall.SIGKILL},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			sig, err := SignalByName(test.name)
+			assert.NoError(t, err)
+			assert.Equal(t, test.sig, sig)
+		})
+	}
+
+	t.Run("UnrecognizedName", func(t *testing.T) {
+		sig, err := SignalByName("NOTASIGNAL")
+		assert.Error(t, err)
+		assert.Zero(t, sig)
+	})
+}